@@ -1,15 +1,10 @@
 module github.com/arcsub/go-moysklad
 
-go 1.22.0
+go 1.23.0
 
 require (
 	github.com/go-resty/resty/v2 v2.16.5
 	github.com/google/go-querystring v1.1.0
-	go.uber.org/ratelimit v0.3.1
 )
 
-require (
-	github.com/benbjohnson/clock v1.3.0 // indirect
-	golang.org/x/net v0.33.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
-)
+require golang.org/x/net v0.33.0 // indirect
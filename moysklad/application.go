@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Application Серверное приложение.
@@ -67,6 +68,21 @@ type ApplicationService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Application], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех установленных приложений.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Application, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех установленных приложений.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Application]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех установленных приложений асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Application, error]
+
 	// GetByID выполняет запрос на получение сущности установленного приложения.
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает объект Application.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/go-resty/resty/v2"
 	"reflect"
 )
@@ -416,13 +417,13 @@ func NewBarcodes() Slice[Barcode] {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-towar-towary-atributy-wlozhennyh-suschnostej-zakupochnaq-cena
 type BuyPrice struct {
-	Value    *float64  `json:"value,omitempty"`    // Значение цены
+	Value    *Money    `json:"value,omitempty"`    // Значение цены
 	Currency *Currency `json:"currency,omitempty"` // Метаданные валюты
 }
 
 // GetValue возвращает Значение цены.
 func (buyPrice BuyPrice) GetValue() float64 {
-	return Deref(buyPrice.Value)
+	return Deref(buyPrice.Value).Float()
 }
 
 // GetCurrency возвращает Метаданные валюты.
@@ -432,7 +433,7 @@ func (buyPrice BuyPrice) GetCurrency() Currency {
 
 // SetValue устанавливает Значение цены.
 func (buyPrice *BuyPrice) SetValue(value *float64) *BuyPrice {
-	buyPrice.Value = value
+	buyPrice.Value = moneyFromFloatPtr(value)
 	return buyPrice
 }
 
@@ -453,13 +454,13 @@ func (buyPrice BuyPrice) String() string {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-towar-towary-atributy-wlozhennyh-suschnostej-minimal-naq-cena
 type MinPrice struct {
-	Value    *float64  `json:"value,omitempty"`    // Значение цены
+	Value    *Money    `json:"value,omitempty"`    // Значение цены
 	Currency *Currency `json:"currency,omitempty"` // Ссылка на валюту в формате Метаданных
 }
 
 // GetValue возвращает Значение цены.
 func (minPrice MinPrice) GetValue() float64 {
-	return Deref(minPrice.Value)
+	return Deref(minPrice.Value).Float()
 }
 
 // GetCurrency возвращает Ссылку на валюту в формате Метаданных.
@@ -469,7 +470,7 @@ func (minPrice MinPrice) GetCurrency() Currency {
 
 // SetValue устанавливает Значение цены.
 func (minPrice *MinPrice) SetValue(value float64) *MinPrice {
-	minPrice.Value = &value
+	minPrice.Value = NewMoney(MoneyFromFloat(value))
 	return minPrice
 }
 
@@ -492,14 +493,14 @@ func (minPrice MinPrice) String() string {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-towar-towary-atributy-wlozhennyh-suschnostej-ceny-prodazhi
 type SalePrice struct {
-	Value     *float64   `json:"value,omitempty"`     // Значение цены
+	Value     *Money     `json:"value,omitempty"`     // Значение цены
 	Currency  *Currency  `json:"currency,omitempty"`  // Ссылка на валюту в формате Метаданных
 	PriceType *PriceType `json:"priceType,omitempty"` // Тип цены
 }
 
 // GetValue возвращает Значение цены.
 func (salePrice SalePrice) GetValue() float64 {
-	return Deref(salePrice.Value)
+	return Deref(salePrice.Value).Float()
 }
 
 // GetCurrency возвращает Ссылку на валюту в формате Метаданных.
@@ -514,7 +515,7 @@ func (salePrice SalePrice) GetPriceType() PriceType {
 
 // SetValue устанавливает Значение цены.
 func (salePrice *SalePrice) SetValue(value float64) *SalePrice {
-	salePrice.Value = &value
+	salePrice.Value = NewMoney(MoneyFromFloat(value))
 	return salePrice
 }
 
@@ -667,8 +668,9 @@ type AssortmentService interface {
 
 	// DeleteMany выполняет запрос на массовое удаление позиций в Ассортименте.
 	// Принимает контекст и множество объектов, реализующих интерфейс AssortmentConverter.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...AssortmentConverter) (*DeleteManyResponse, *resty.Response, error)
+	// Объекты, равные nil, не передаются, индексы элементов результата соответствуют оставшимся объектам.
+	// Возвращает результат массовой операции BulkResult.
+	DeleteMany(ctx context.Context, entities ...AssortmentConverter) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetSettings выполняет запрос на получение настроек справочника ассортимента.
 	// Принимает контекст.
@@ -701,26 +703,18 @@ func (service *assortmentService) GetListAll(ctx context.Context, params ...func
 }
 
 func (service *assortmentService) GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[AssortmentResponse], *resty.Response, error) {
-	params = append(params, WithAsync())
-
-	_, resp, err := NewRequestBuilder[any](service.client, service.uri).SetParams(params).Get(ctx)
-	if err != nil {
-		return nil, resp, nil
-	}
-
-	async := NewAsyncResultService[AssortmentResponse](service.client, resp)
-
-	return async, resp, err
+	return NewRequestBuilder[AssortmentResponse](service.client, service.uri).SetParams(params).Async(ctx)
 }
 
-func (service *assortmentService) DeleteMany(ctx context.Context, entities ...AssortmentConverter) (*DeleteManyResponse, *resty.Response, error) {
-	var mw = make([]MetaWrapper, 0, len(entities))
+func (service *assortmentService) DeleteMany(ctx context.Context, entities ...AssortmentConverter) (*BulkResult[DeleteManyRow], *resty.Response, error) {
+	var positions = make(Slice[AssortmentPosition], 0, len(entities))
 	for _, entity := range entities {
 		if entity != nil {
-			mw = append(mw, entity.AsAssortment().GetMeta().Wrap())
+			positions = append(positions, entity.AsAssortment())
 		}
 	}
-	return NewRequestBuilder[DeleteManyResponse](service.client, service.uri).Post(ctx, mw)
+	path := fmt.Sprintf("%s/delete", service.uri)
+	return deleteAll[AssortmentPosition](ctx, service.client, path, positions)
 }
 
 func (service *assortmentService) GetSettings(ctx context.Context) (*AssortmentSettings, *resty.Response, error) {
@@ -1,9 +1,12 @@
 package moysklad
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"time"
 
 	"net/http"
 )
@@ -52,6 +55,87 @@ const (
 	AsyncStateApiError   AsyncState = "API_ERROR"  // Задача была завершена с ошибкой апи
 )
 
+// Terminal возвращает true, если задача завершена и её статус больше не изменится.
+func (asyncState AsyncState) Terminal() bool {
+	switch asyncState {
+	case AsyncStateDone, AsyncStateError, AsyncStateCancel, AsyncStateApiError:
+		return true
+	default:
+		return false
+	}
+}
+
+// Ошибки завершения Асинхронной задачи.
+//
+// Проверяются с помощью [errors.Is] у ошибки [AsyncError].
+var (
+	ErrAsyncFailed   = errors.New("moysklad: async task failed")   // Задача завершена с ошибкой (ERROR, API_ERROR)
+	ErrAsyncCanceled = errors.New("moysklad: async task canceled") // Задача отменена (CANCEL)
+)
+
+// AsyncError ошибка завершения Асинхронной задачи со статусом ERROR, CANCEL или API_ERROR.
+//
+// Ошибки апи (для статуса API_ERROR) содержатся в поле Errors
+// и доступны через [errors.As] как [ApiError].
+type AsyncError struct {
+	ID     string     // ID Асинхронной задачи
+	State  AsyncState // Статус выполнения Асинхронной задачи
+	Errors ApiErrors  // Ошибки апи
+}
+
+// newAsyncError возвращает [AsyncError] для задачи, завершённой неуспешно, иначе nil.
+func newAsyncError(async *Async) error {
+	switch async.State {
+	case AsyncStateError, AsyncStateCancel, AsyncStateApiError:
+		return &AsyncError{ID: async.ID, State: async.State, Errors: async.Errors}
+	default:
+		return nil
+	}
+}
+
+func (asyncError *AsyncError) Error() string {
+	msg := fmt.Sprintf("moysklad: async task %s finished with state %s", asyncError.ID, asyncError.State)
+	if len(asyncError.Errors.ApiErrors) > 0 {
+		msg += ": " + asyncError.Errors.Error()
+	}
+	return msg
+}
+
+// Unwrap возвращает [ErrAsyncCanceled] или [ErrAsyncFailed] и ошибки апи.
+func (asyncError *AsyncError) Unwrap() []error {
+	errs := []error{ErrAsyncFailed}
+	if asyncError.State == AsyncStateCancel {
+		errs[0] = ErrAsyncCanceled
+	}
+	return append(errs, asyncError.Errors.Unwrap()...)
+}
+
+const (
+	DefaultAsyncMinInterval = time.Second      // Начальный интервал проверки статуса Асинхронной задачи по умолчанию
+	DefaultAsyncMaxInterval = 30 * time.Second // Максимальный интервал проверки статуса Асинхронной задачи по умолчанию
+)
+
+// AsyncWaitOptions параметры ожидания результата Асинхронной задачи.
+type AsyncWaitOptions struct {
+	// Максимальное время ожидания. По истечении задача отменяется.
+	//
+	// Нулевое значение – без ограничения (кроме контекста).
+	Timeout time.Duration
+
+	// Начальный интервал проверки статуса. Удваивается после каждой проверки.
+	//
+	// По умолчанию [DefaultAsyncMinInterval].
+	MinInterval time.Duration
+
+	// Максимальный интервал проверки статуса.
+	//
+	// По умолчанию [DefaultAsyncMaxInterval].
+	MaxInterval time.Duration
+
+	// Не отменять задачу при отмене контекста или истечении времени ожидания.
+	KeepOnCancel bool
+}
+
 // AsyncService методы сервиса для работы с асинхронными задачами.
 //
 // [Документация МойСклад]
@@ -85,6 +169,9 @@ func (service *asyncService) GetStatusByID(ctx context.Context, id string) (*Asy
 }
 
 // AsyncResultService методы сервиса для обработки асинхронного запроса.
+//
+// Задача, созданная клиентом, занимает одно из [MaxAsyncTasks] мест очереди клиента до тех пор,
+// пока не будет получен её конечный статус, результат или пока она не будет отменена.
 type AsyncResultService[T any] interface {
 	// StatusURL возвращает URL проверки статуса асинхронной задачи.
 	StatusURL() string
@@ -92,23 +179,37 @@ type AsyncResultService[T any] interface {
 	// ResultURL возвращает URL результата выполнения асинхронной задачи.
 	ResultURL() string
 
+	// Status выполняет запрос на получение статуса асинхронной задачи.
+	// Если задача завершена, освобождает её место в очереди.
+	// Возвращает объект Async.
+	Status(ctx context.Context) (*Async, *resty.Response, error)
+
 	// Check выполняет запрос на проверку статус асинхронной задачи.
 	// Возвращает true, если статус задачи имеет значение AsyncStateDone (DONE).
+	// Возвращает ошибку AsyncError, если задача завершена с ошибкой или отменена.
 	Check(ctx context.Context) (bool, *resty.Response, error)
 
-	// Result выполняет запрос на получение результата.
+	// Result выполняет запрос на получение результата и освобождает место задачи в очереди.
 	// Возвращает объект обобщённого типа, который был указан при создании сервиса для обработки асинхронного запроса.
 	Result(ctx context.Context) (*T, *resty.Response, error)
 
-	// Cancel выполняет запрос на отмену Асинхронной задачи.
+	// Cancel выполняет запрос на отмену Асинхронной задачи и освобождает её место в очереди.
 	// Возвращает true, если задача успешно отменена.
 	Cancel(ctx context.Context) (bool, *resty.Response, error)
+
+	// Wait ожидает завершения асинхронной задачи и возвращает результат.
+	// Статус задачи проверяется с экспоненциально растущим интервалом (см. AsyncWaitOptions).
+	// После завершения освобождает место задачи в очереди.
+	// Возвращает ошибку AsyncError, если задача завершена с ошибкой или отменена.
+	// При отмене контекста или истечении времени ожидания задача отменяется.
+	Wait(ctx context.Context, opts AsyncWaitOptions) (*T, error)
 }
 
 type asyncResultService[T any] struct {
 	client    *Client // Клиент
 	statusURL string  // URL статуса Асинхронной задачи.
 	resultURL string  // URL результата выполнения Асинхронной задачи.
+	release   func()  // Освобождает место задачи в очереди клиента
 }
 
 const (
@@ -119,10 +220,16 @@ const (
 
 // NewAsyncResultService принимает [Client] и возвращает сервис для работы с асинхронной задачей.
 func NewAsyncResultService[T any](client *Client, resp *resty.Response) AsyncResultService[T] {
+	return newAsyncResultService[T](client, resp)
+}
+
+// newAsyncResultService возвращает сервис для работы с асинхронной задачей.
+func newAsyncResultService[T any](client *Client, resp *resty.Response) *asyncResultService[T] {
 	return &asyncResultService[T]{
 		client:    client,
 		statusURL: resp.Header().Get("Location"),
 		resultURL: resp.Header().Get("Content-Location"),
+		release:   func() {},
 	}
 }
 
@@ -134,15 +241,25 @@ func (service *asyncResultService[T]) ResultURL() string {
 	return service.resultURL
 }
 
-func (service *asyncResultService[T]) Check(ctx context.Context) (bool, *resty.Response, error) {
+func (service *asyncResultService[T]) Status(ctx context.Context) (*Async, *resty.Response, error) {
 	async, resp, err := NewRequestBuilder[Async](service.client, service.StatusURL()).Get(ctx)
+	if err == nil && async.State.Terminal() {
+		service.release()
+	}
+	return async, resp, err
+}
+
+func (service *asyncResultService[T]) Check(ctx context.Context) (bool, *resty.Response, error) {
+	async, resp, err := service.Status(ctx)
 	if err != nil {
 		return false, resp, err
 	}
-	return async.State == AsyncStateDone, resp, nil
+	return async.State == AsyncStateDone, resp, newAsyncError(async)
 }
 
 func (service *asyncResultService[T]) Result(ctx context.Context) (*T, *resty.Response, error) {
+	defer service.release()
+
 	data, resp, err := NewRequestBuilder[T](service.client, service.ResultURL()).Get(ctx)
 	if err != nil {
 		return nil, resp, err
@@ -151,6 +268,8 @@ func (service *asyncResultService[T]) Result(ctx context.Context) (*T, *resty.Re
 }
 
 func (service *asyncResultService[T]) Cancel(ctx context.Context) (bool, *resty.Response, error) {
+	defer service.release()
+
 	path := fmt.Sprintf(EndpointAsyncCancel, service.StatusURL())
 	_, resp, err := NewRequestBuilder[any](service.client, path).Post(ctx, nil)
 	if err != nil {
@@ -159,6 +278,86 @@ func (service *asyncResultService[T]) Cancel(ctx context.Context) (bool, *resty.
 	return resp.StatusCode() == http.StatusNoContent, resp, nil
 }
 
+func (service *asyncResultService[T]) Wait(ctx context.Context, opts AsyncWaitOptions) (*T, error) {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	defer service.release()
+
+	if err := service.await(ctx, opts); err != nil {
+		return nil, err
+	}
+
+	data, _, err := service.Result(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// await проверяет статус задачи с экспоненциально растущим интервалом до её завершения.
+func (service *asyncResultService[T]) await(ctx context.Context, opts AsyncWaitOptions) error {
+	interval := cmp.Or(opts.MinInterval, DefaultAsyncMinInterval)
+	maxInterval := cmp.Or(opts.MaxInterval, DefaultAsyncMaxInterval)
+
+	for {
+		done, _, err := service.Check(ctx)
+		if err != nil {
+			return service.abort(ctx, opts, err)
+		}
+
+		if done {
+			return nil
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return service.abort(ctx, opts, err)
+		}
+
+		interval = min(interval*2, maxInterval)
+	}
+}
+
+// abort отменяет задачу, если ожидание прервано отменой контекста.
+func (service *asyncResultService[T]) abort(ctx context.Context, opts AsyncWaitOptions, err error) error {
+	if ctx.Err() == nil || opts.KeepOnCancel {
+		return err
+	}
+
+	cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), DefaultAsyncMaxInterval)
+	defer cancel()
+
+	if _, _, cancelErr := service.Cancel(cancelCtx); cancelErr != nil {
+		return errors.Join(err, cancelErr)
+	}
+	return err
+}
+
+// asyncQueue ограничивает количество одновременно выполняемых асинхронных задач клиента.
+//
+// Место занимается перед созданием задачи, поэтому запросы, превышающие ограничение [MaxAsyncTasks],
+// ожидают завершения ранее созданных задач, не обращаясь к сервису.
+type asyncQueue chan struct{}
+
+// acquire занимает место в очереди и возвращает функцию его освобождения.
+//
+// Возвращает ошибку ctx.Err() при отмене контекста.
+func (queue asyncQueue) acquire(ctx context.Context) (func(), error) {
+	if queue == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case queue <- struct{}{}:
+		return func() { <-queue }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // NewAsyncService принимает [Client] и возвращает сервис для работы с асинхронными задачами.
 func NewAsyncService(client *Client) AsyncService {
 	return &asyncService{NewEndpoint(client, EndpointAsync)}
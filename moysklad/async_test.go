@@ -0,0 +1,121 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/mstest"
+)
+
+func TestAsyncTasksLimit(t *testing.T) {
+	server := mstest.NewServer(mstest.WithAsyncDelay(time.Hour))
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var tasks []moysklad.AsyncResultService[moysklad.List[moysklad.Product]]
+	for range moysklad.MaxAsyncTasks {
+		async, _, err := client.Entity().Product().GetListAsync(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tasks = append(tasks, async)
+	}
+
+	// задача сверх ограничения не создаётся, пока не освободится место
+	requests := server.Requests()
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer waitCancel()
+
+	if _, _, err := client.Entity().Product().GetListAsync(waitCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want context.DeadlineExceeded", err)
+	}
+	if n := server.Requests(); n != requests {
+		t.Fatalf("got %d requests, want %d", n, requests)
+	}
+
+	// отмена задачи освобождает место
+	if _, _, err := tasks[0].Cancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	async, _, err := client.Entity().Product().GetListAsync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, task := range append(tasks[1:], async) {
+		if _, _, err = task.Cancel(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for range moysklad.MaxAsyncTasks {
+		if _, _, err = client.Entity().Product().GetListAsync(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAsyncReleasesSlot(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+	if err := server.Seed(&moysklad.Product{Name: moysklad.String("Товар")}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// каждая задача освобождает место после получения результата
+	for range moysklad.MaxAsyncTasks + 1 {
+		async, _, err := client.Entity().Product().GetListAsync(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		list, err := async.Wait(ctx, moysklad.AsyncWaitOptions{MinInterval: time.Millisecond})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list.Rows) != 1 {
+			t.Fatalf("got %d rows, want 1", len(list.Rows))
+		}
+	}
+
+	// получение конечного статуса освобождает место
+	for range moysklad.MaxAsyncTasks + 1 {
+		async, _, err := client.Entity().Product().GetListAsync(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for done := false; !done; {
+			if done, _, err = async.Check(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	var count int
+	for range moysklad.MaxAsyncTasks + 1 {
+		for _, err := range client.Entity().Product().GetListAllAsync(ctx) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			count++
+		}
+	}
+	if count != moysklad.MaxAsyncTasks+1 {
+		t.Fatalf("got %d rows, want %d", count, moysklad.MaxAsyncTasks+1)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
-	"log"
 )
 
 // Audit Контексты Аудита.
@@ -168,12 +167,10 @@ func (diff Diff) GetSalesPrices() (bool, SalePriceElem) {
 		var t OldNew[[]any]
 		b, err := json.Marshal(salePrices)
 		if err != nil {
-			log.Println(err)
 			return false, o
 		}
 
 		if err = json.Unmarshal(b, &t); err != nil {
-			log.Println(err)
 			return false, o
 		}
 
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // BonusProgram Бонусная программа.
@@ -272,6 +273,21 @@ type BonusProgramService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[BonusProgram], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех бонусных программ.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*BonusProgram, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех бонусных программ.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[BonusProgram]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех бонусных программ асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*BonusProgram, error]
+
 	// Create выполняет запрос на создание бонусной программы.
 	// Обязательные поля для заполнения:
 	//	- name (имя бонусной программы)
@@ -307,8 +323,8 @@ type BonusProgramService interface {
 
 	// DeleteMany выполняет запрос на массовое удаление бонусных программ.
 	// Принимает контекст и множество бонусных программ.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*BonusProgram) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*BonusProgram) (*BulkResult[DeleteManyRow], *resty.Response, error)
 }
 
 const (
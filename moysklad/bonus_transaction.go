@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -355,6 +356,21 @@ type BonusTransactionService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[BonusTransaction], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех бонусных операций.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*BonusTransaction, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех бонусных операций.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[BonusTransaction]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех бонусных операций асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*BonusTransaction, error]
+
 	// Create выполняет запрос на создание бонусной операции.
 	// Обязательные поля для заполнения:
 	//	- agent (Метаданные Контрагента, связанного с бонусной операцией)
@@ -367,13 +383,13 @@ type BonusTransactionService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение бонусных операций.
 	// Изменяемые Бонусные операции должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список бонусных операций и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых бонусных операций.
-	CreateUpdateMany(ctx context.Context, bonusTransactionList Slice[BonusTransaction], params ...func(*Params)) (*Slice[BonusTransaction], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых бонусных операций и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, bonusTransactionList Slice[BonusTransaction], params ...func(*Params)) (*BulkResult[BonusTransaction], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление бонусных операций.
 	// Принимает контекст и множество бонусных операций.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*BonusTransaction) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*BonusTransaction) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление бонусной операции по ID.
 	// Принимает контекст и ID бонусной операции.
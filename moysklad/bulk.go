@@ -0,0 +1,262 @@
+package moysklad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"sync"
+)
+
+// BulkItem результат обработки одного элемента массовой операции.
+type BulkItem[T any] struct {
+	Entity *T    // Созданный, изменённый или удалённый объект. Равен nil, если при обработке элемента произошла ошибка
+	Error  error // Ошибка обработки элемента: ApiErrors или ошибка выполнения запроса
+	Index  int   // Индекс элемента во входных данных
+	Chunk  int   // Номер части запроса, в которой был передан элемент
+}
+
+// BulkChunk результат отправки одной части массовой операции.
+//
+// Входные данные разбиваются на части размером не более [MaxPositions] элементов.
+type BulkChunk struct {
+	Response *resty.Response // Ответ на запрос. Равен nil, если запрос не был выполнен
+	Error    error           // Ошибка части запроса
+	Index    int             // Номер части
+	Offset   int             // Индекс первого элемента части во входных данных
+	Len      int             // Количество элементов в части
+
+	canceled bool // часть отменена после ошибки другой части в режиме fail-fast
+}
+
+// BulkResult результат массовой операции.
+//
+// Элементы Items расположены в порядке передачи входных данных.
+type BulkResult[T any] struct {
+	Items  []BulkItem[T] // Результаты обработки каждого элемента
+	Chunks []BulkChunk   // Результаты отправки каждой части
+}
+
+// String реализует интерфейс [fmt.Stringer].
+func (bulkResult BulkResult[T]) String() string {
+	return Stringify(bulkResult)
+}
+
+// Entities возвращает успешно обработанные объекты в порядке передачи входных данных.
+func (bulkResult *BulkResult[T]) Entities() Slice[T] {
+	var entities = make(Slice[T], 0, len(bulkResult.Items))
+	for _, item := range bulkResult.Items {
+		entities.Push(item.Entity)
+	}
+	return entities
+}
+
+// Failed возвращает элементы, при обработке которых произошла ошибка.
+func (bulkResult *BulkResult[T]) Failed() []BulkItem[T] {
+	var failed []BulkItem[T]
+	for _, item := range bulkResult.Items {
+		if item.Error != nil {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// HasErrors возвращает true, если хотя бы один элемент не был обработан.
+func (bulkResult *BulkResult[T]) HasErrors() bool {
+	for _, item := range bulkResult.Items {
+		if item.Error != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Err возвращает ошибки всех частей запроса, объединённые с помощью [errors.Join].
+//
+// Если ошибка возникла только в одной части, возвращается эта ошибка.
+// Ошибки [context.Canceled] частей, отменённых после ошибки другой части (см. [WithBulkFailFast]), не возвращаются.
+func (bulkResult *BulkResult[T]) Err() error {
+	var errs []error
+	for _, chunk := range bulkResult.Chunks {
+		if chunk.Error != nil && !chunk.canceled {
+			errs = append(errs, chunk.Error)
+		}
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+type bulkFailFastKey struct{}
+
+// WithBulkFailFast возвращает контекст, при использовании которого массовая операция
+// после первой ошибки прекращает отправку оставшихся частей и отменяет выполняемые запросы.
+// Элементы неотправленных и отменённых частей содержат ошибку [context.Canceled],
+// которая не включается в [BulkResult.Err].
+//
+// По умолчанию отправляются все части, независимо от ошибок в других частях.
+func WithBulkFailFast(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bulkFailFastKey{}, true)
+}
+
+func isBulkFailFast(ctx context.Context) bool {
+	failFast, _ := ctx.Value(bulkFailFastKey{}).(bool)
+	return failFast
+}
+
+// bulkAll разбивает entities на части по [MaxPositions] элементов и отправляет их параллельно методом POST,
+// не более [MaxQueriesPerUser] частей одновременно. Если entities пуст, запрос не выполняется.
+//
+// Тело каждой части формируется функцией body. Элементы ответа сопоставляются с входными данными по индексу.
+func bulkAll[E any, R any](ctx context.Context, client *Client, path string, entities Slice[E], params []func(*Params), body func(Slice[E]) any) (*BulkResult[R], *resty.Response, error) {
+	var result = &BulkResult[R]{Items: make([]BulkItem[R], entities.Len())}
+
+	if entities.Len() == 0 {
+		return result, nil, nil
+	}
+
+	for offset := 0; offset < entities.Len(); offset += MaxPositions {
+		result.Chunks = append(result.Chunks, BulkChunk{
+			Index:  len(result.Chunks),
+			Offset: offset,
+			Len:    min(MaxPositions, entities.Len()-offset),
+		})
+	}
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	failFast := isBulkFailFast(ctx)
+
+	send := func(chunk *BulkChunk) {
+		items := result.Items[chunk.Offset : chunk.Offset+chunk.Len]
+		for j := range items {
+			items[j].Index = chunk.Offset + j
+			items[j].Chunk = chunk.Index
+		}
+
+		// после ошибки в режиме fail-fast оставшиеся части не отправляются
+		if err := ctx.Err(); err != nil {
+			chunk.Error = err
+		} else {
+			requestBuilder := NewRequestBuilder[R](client, path).SetParams(params)
+			requestBuilder.req.SetBody(ConvertTimestamps(body(entities[chunk.Offset:chunk.Offset+chunk.Len]), client.Location(), Location()))
+
+			chunk.Response, chunk.Error = requestBuilder.execute(ctx, http.MethodPost)
+			if chunk.Error == nil {
+				chunk.Error = parseBulkResponse(chunk.Response, items)
+				for j := range items {
+					items[j].Entity = ConvertTimestamps(items[j].Entity, Location(), client.Location())
+				}
+				if chunk.Error != nil && failFast {
+					cancel()
+				}
+				return
+			}
+		}
+
+		for j := range items {
+			items[j].Error = chunk.Error
+		}
+
+		if !failFast {
+			return
+		}
+
+		// часть отменена ошибкой другой части, а не отменой контекста вызывающего
+		if errors.Is(chunk.Error, context.Canceled) && ctx.Err() != nil && parent.Err() == nil {
+			chunk.canceled = true
+			return
+		}
+		cancel()
+	}
+
+	chunks := make(chan *BulkChunk, len(result.Chunks))
+	for i := range result.Chunks {
+		chunks <- &result.Chunks[i]
+	}
+	close(chunks)
+
+	var wg sync.WaitGroup
+	for range min(MaxQueriesPerUser, len(result.Chunks)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for chunk := range chunks {
+				send(chunk)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	var resp *resty.Response
+	for _, chunk := range result.Chunks {
+		if chunk.Response != nil {
+			resp = chunk.Response
+		}
+		if chunk.Error != nil {
+			return result, resp, result.Err()
+		}
+	}
+
+	return result, resp, nil
+}
+
+// parseBulkResponse сопоставляет элементы ответа на массовый запрос с элементами items.
+//
+// Возвращает ошибку [ApiErrors], содержащую ошибки всех необработанных элементов.
+func parseBulkResponse[R any](resp *resty.Response, items []BulkItem[R]) error {
+	var rows []json.RawMessage
+
+	// при передаче одного элемента сервис может вернуть объект вместо массива
+	if err := json.Unmarshal(resp.Body(), &rows); err != nil && len(items) == 1 && resp.StatusCode() < http.StatusBadRequest {
+		rows = []json.RawMessage{resp.Body()}
+	}
+
+	if len(rows) != len(items) {
+		var apiErrors ApiErrors
+		_ = json.Unmarshal(resp.Body(), &apiErrors)
+
+		var err error = newApiErrors(resp, apiErrors.ApiErrors)
+		if resp.StatusCode() < http.StatusBadRequest {
+			err = fmt.Errorf("bulk: expected %d elements in response, got %d", len(items), len(rows))
+		}
+
+		for i := range items {
+			items[i].Error = err
+		}
+
+		return err
+	}
+
+	var failed Slice[ApiError]
+	for i, row := range rows {
+		var apiErrors ApiErrors
+		if err := json.Unmarshal(row, &apiErrors); err == nil && len(apiErrors.ApiErrors) > 0 {
+			items[i].Error = newApiErrors(resp, apiErrors.ApiErrors)
+			failed = append(failed, apiErrors.ApiErrors...)
+			continue
+		}
+
+		var entity R
+		if err := json.Unmarshal(row, &entity); err != nil {
+			items[i].Error = err
+			continue
+		}
+		items[i].Entity = &entity
+	}
+
+	if len(failed) > 0 || resp.StatusCode() >= http.StatusBadRequest {
+		return newApiErrors(resp, failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/mstest"
+)
+
+// newProducts возвращает n товаров с названиями по порядку.
+func newProducts(n int) moysklad.Slice[moysklad.Product] {
+	products := make(moysklad.Slice[moysklad.Product], 0, n)
+	for i := range n {
+		products.Push(&moysklad.Product{Name: moysklad.String(fmt.Sprint("Товар ", i))})
+	}
+	return products
+}
+
+func TestBulkCreateUpdateMany(t *testing.T) {
+	server := mstest.NewServer(mstest.WithRateLimit(0, 0))
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+
+	const n = 2*moysklad.MaxPositions + 500
+
+	result, _, err := client.Entity().Product().CreateUpdateMany(context.Background(), newProducts(n))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Chunks) != 3 || server.Requests() != 3 {
+		t.Fatalf("got %d chunks and %d requests, want 3", len(result.Chunks), server.Requests())
+	}
+	if result.HasErrors() || len(result.Items) != n {
+		t.Fatalf("got %d items with errors %v, want %d", len(result.Items), result.Err(), n)
+	}
+
+	for i, item := range result.Items {
+		if item.Index != i || item.Chunk != i/moysklad.MaxPositions {
+			t.Fatalf("item %d: got index %d and chunk %d", i, item.Index, item.Chunk)
+		}
+		if name := fmt.Sprint("Товар ", i); item.Entity.GetName() != name {
+			t.Fatalf("item %d: got name %q, want %q", i, item.Entity.GetName(), name)
+		}
+	}
+
+	if count := server.Len(moysklad.MetaTypeProduct); count != n {
+		t.Fatalf("got %d products, want %d", count, n)
+	}
+}
+
+func TestBulkEmpty(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+
+	result, resp, err := client.Entity().Product().CreateUpdateMany(context.Background(), moysklad.Slice[moysklad.Product]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp != nil || len(result.Items) != 0 || len(result.Chunks) != 0 {
+		t.Fatalf("got %d items and %d chunks, want empty result without response", len(result.Items), len(result.Chunks))
+	}
+	if n := server.Requests(); n != 0 {
+		t.Fatalf("got %d requests, want 0", n)
+	}
+}
+
+func TestBulkFailFastCancelsChunks(t *testing.T) {
+	// последняя, неполная часть завершается ошибкой, остальные ожидают отмены запроса
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Count(string(body), `"name"`) == moysklad.MaxPositions {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"error":"Ошибка","code":1000}]}`))
+	}))
+	defer server.Close()
+
+	client := moysklad.New(moysklad.Config{BaseURL: server.URL, Token: "test"})
+	ctx := moysklad.WithBulkFailFast(context.Background())
+
+	started := time.Now()
+	result, _, err := client.Entity().Product().CreateUpdateMany(ctx, newProducts(2*moysklad.MaxPositions+1))
+	if err == nil {
+		t.Fatal("got no error, want error")
+	}
+	if elapsed := time.Since(started); elapsed > 3*time.Second {
+		t.Fatalf("got bulk duration %s, want in-flight chunks cancelled", elapsed)
+	}
+
+	for _, chunk := range result.Chunks[:2] {
+		if !errors.Is(chunk.Error, context.Canceled) {
+			t.Fatalf("chunk %d: got error %v, want context.Canceled", chunk.Index, chunk.Error)
+		}
+	}
+
+	// ошибки частей, отменённых после ошибки, не возвращаются
+	for _, err := range []error{err, result.Err()} {
+		var apiErrors moysklad.ApiErrors
+		if errors.Is(err, context.Canceled) || !errors.As(err, &apiErrors) {
+			t.Fatalf("got error %v, want only api errors", err)
+		}
+	}
+}
+
+func TestBulkCallerCancelKeepsErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(moysklad.WithBulkFailFast(context.Background()))
+	cancel()
+
+	client := moysklad.New(moysklad.Config{BaseURL: "http://127.0.0.1:0", Token: "test"})
+	result, _, err := client.Entity().Product().CreateUpdateMany(ctx, newProducts(2))
+	if !errors.Is(err, context.Canceled) || !errors.Is(result.Err(), context.Canceled) {
+		t.Fatalf("got errors %v and %v, want context.Canceled of the caller", err, result.Err())
+	}
+}
+
+func TestAssortmentDeleteMany(t *testing.T) {
+	var (
+		path  string
+		metas int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		path, metas = r.URL.Path, strings.Count(string(body), `"meta"`)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"info":"Сущность удалена"},{"errors":[{"error":"Нельзя удалить","code":1000}]}]`))
+	}))
+	defer server.Close()
+
+	client := moysklad.New(moysklad.Config{BaseURL: server.URL, Token: "test"})
+
+	product := func(id string) *moysklad.Product {
+		product := &moysklad.Product{ID: moysklad.String(id)}
+		return product.SetMeta(new(moysklad.Meta).SetHref(server.URL + "/entity/product/" + id).SetType(moysklad.MetaTypeProduct))
+	}
+
+	result, _, err := client.Entity().Assortment().DeleteMany(context.Background(), product("1"), nil, product("2"))
+	var apiErrors moysklad.ApiErrors
+	if !errors.As(err, &apiErrors) {
+		t.Fatalf("got error %v, want api errors", err)
+	}
+
+	if path != "/entity/assortment/delete" || metas != 2 {
+		t.Fatalf("got request %s with %d objects, want /entity/assortment/delete with 2", path, metas)
+	}
+	if len(result.Items) != 2 || result.Items[0].Error != nil || result.Items[0].Entity.Info == "" || result.Items[1].Error == nil {
+		t.Fatalf("got result %s, want one deleted and one failed", result)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -538,13 +539,13 @@ func (bundle *Bundle) Delete(ctx context.Context, client *Client) (bool, *resty.
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-komplekt-komplekty-atributy-wlozhennyh-suschnostej-dopolnitel-nye-rashody
 type BundleOverhead struct {
-	Value    *float64  `json:"value,omitempty"`    // Значение цены
+	Value    *Money    `json:"value,omitempty"`    // Значение цены
 	Currency *Currency `json:"currency,omitempty"` // Метаданные валюты
 }
 
 // GetValue возвращает Значение цены.
 func (bundleOverhead BundleOverhead) GetValue() float64 {
-	return Deref(bundleOverhead.Value)
+	return Deref(bundleOverhead.Value).Float()
 }
 
 // GetCurrency возвращает Метаданные валюты.
@@ -554,7 +555,7 @@ func (bundleOverhead BundleOverhead) GetCurrency() Currency {
 
 // SetValue устанавливает Значение цены.
 func (bundleOverhead *BundleOverhead) SetValue(value *float64) *BundleOverhead {
-	bundleOverhead.Value = value
+	bundleOverhead.Value = moneyFromFloatPtr(value)
 	return bundleOverhead
 }
 
@@ -649,6 +650,21 @@ type BundleService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Bundle], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех комплектов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Bundle, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех комплектов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Bundle]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех комплектов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Bundle, error]
+
 	// Create выполняет запрос на создание бонусной программы.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование комплекта)
@@ -660,8 +676,8 @@ type BundleService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение комплектов.
 	// Изменяемые комплекты должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список комплектов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых комплектов.
-	CreateUpdateMany(ctx context.Context, bundleList Slice[Bundle], params ...func(*Params)) (*Slice[Bundle], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых комплектов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, bundleList Slice[Bundle], params ...func(*Params)) (*BulkResult[Bundle], *resty.Response, error)
 
 	// GetByID выполняет запрос на получение комплекта по ID.
 	// Принимает контекст, ID комплекта и опционально объект параметров запроса Params.
@@ -685,8 +701,8 @@ type BundleService interface {
 
 	// DeleteMany выполняет запрос на массовое удаление комплектов.
 	// Принимает контекст и множество комплектов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Bundle) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Bundle) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetComponentList выполняет запрос на получение компонентов комплекта в виде списка.
 	// Принимает контекст и ID комплекта.
@@ -781,8 +797,8 @@ type BundleService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -796,8 +812,8 @@ type BundleService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetBySyncID выполняет запрос на получение отдельного документа по syncID.
 	// Принимает контекст и syncID документа.
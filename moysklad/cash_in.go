@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -16,7 +17,7 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-prihodnyj-order
 type CashIn struct {
 	Organization   *Organization            `json:"organization,omitempty"`   // Метаданные юрлица
-	VatSum         *float64                 `json:"vatSum,omitempty"`         // Сумма НДС
+	VatSum         *Money                   `json:"vatSum,omitempty"`         // Сумма НДС
 	Applicable     *bool                    `json:"applicable,omitempty"`     // Отметка о проведении
 	Moment         *Timestamp               `json:"moment,omitempty"`         // Дата документа
 	Code           *string                  `json:"code,omitempty"`           // Код Приходного ордера
@@ -41,7 +42,7 @@ type CashIn struct {
 	SalesChannel   *NullValue[SalesChannel] `json:"salesChannel,omitempty"`   // Метаданные канала продаж
 	Shared         *bool                    `json:"shared,omitempty"`         // Общий доступ
 	State          *NullValue[State]        `json:"state,omitempty"`          // Метаданные статуса Приходного ордера
-	Sum            *float64                 `json:"sum,omitempty"`            // Сумма Приходного ордера в установленной валюте
+	Sum            *Money                   `json:"sum,omitempty"`            // Сумма Приходного ордера в установленной валюте
 	SyncID         *string                  `json:"syncId,omitempty"`         // ID синхронизации
 	Updated        *Timestamp               `json:"updated,omitempty"`        // Момент последнего обновления Приходного ордера
 	Name           *string                  `json:"name,omitempty"`           // Наименование Приходного ордера
@@ -81,7 +82,7 @@ func (cashIn CashIn) GetOrganization() Organization {
 
 // GetVatSum возвращает Сумму НДС.
 func (cashIn CashIn) GetVatSum() float64 {
-	return Deref(cashIn.VatSum)
+	return Deref(cashIn.VatSum).Float()
 }
 
 // GetApplicable возвращает Отметку о проведении.
@@ -206,7 +207,7 @@ func (cashIn CashIn) GetState() State {
 
 // GetSum возвращает Сумму Приходного ордера в установленной валюте.
 func (cashIn CashIn) GetSum() float64 {
-	return Deref(cashIn.Sum)
+	return Deref(cashIn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -386,7 +387,7 @@ func (cashIn *CashIn) SetState(state *State) *CashIn {
 
 // SetSum устанавливает Сумму Приходного ордера в установленной валюте.
 func (cashIn *CashIn) SetSum(sum *float64) *CashIn {
-	cashIn.Sum = sum
+	cashIn.Sum = moneyFromFloatPtr(sum)
 	return cashIn
 }
 
@@ -455,6 +456,21 @@ type CashInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CashIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех приходных ордеров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CashIn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех приходных ордеров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[CashIn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех приходных ордеров асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*CashIn, error]
+
 	// Create выполняет запрос на создание приходного ордера.
 	// Обязательные поля для заполнения:
 	//	- organization (Метаданные юрлица)
@@ -466,13 +482,13 @@ type CashInService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение приходных ордеров.
 	// Изменяемые приходные ордеры должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список приходных ордеров и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых приходных ордеров.
-	CreateUpdateMany(ctx context.Context, cashInList Slice[CashIn], params ...func(*Params)) (*Slice[CashIn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых приходных ордеров и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, cashInList Slice[CashIn], params ...func(*Params)) (*BulkResult[CashIn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление приходных ордеров.
 	// Принимает контекст и множество приходных ордеров.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*CashIn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*CashIn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление приходного ордера по ID.
 	// Принимает контекст и ID приходного ордера.
@@ -507,8 +523,8 @@ type CashInService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -522,8 +538,8 @@ type CashInService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// Template выполняет запрос на получение предзаполненного приходного ордера со стандартными полями без связи с какими-либо другими документами.
 	// Принимает контекст.
@@ -603,8 +619,8 @@ type CashInService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -43,10 +44,10 @@ type CashOut struct {
 	SalesChannel   *NullValue[SalesChannel] `json:"salesChannel,omitempty"`   // Метаданные канала продаж
 	Shared         *bool                    `json:"shared,omitempty"`         // Общий доступ
 	State          *NullValue[State]        `json:"state,omitempty"`          // Метаданные статуса Расходного ордера
-	Sum            *float64                 `json:"sum,omitempty"`            // Сумма расходного ордера в установленной валюте
+	Sum            *Money                   `json:"sum,omitempty"`            // Сумма расходного ордера в установленной валюте
 	SyncID         *string                  `json:"syncId,omitempty"`         // ID синхронизации
 	Updated        *Timestamp               `json:"updated,omitempty"`        // Момент последнего обновления Расходного ордера
-	VatSum         *float64                 `json:"vatSum,omitempty"`         // Сумма НДС
+	VatSum         *Money                   `json:"vatSum,omitempty"`         // Сумма НДС
 	FactureOut     *FactureOut              `json:"factureOut,omitempty"`     // Ссылка на выданный счет-фактуру, с которым связан этот платеж
 	Attributes     Slice[Attribute]         `json:"attributes,omitempty"`     // Список метаданных доп. полей
 }
@@ -225,7 +226,7 @@ func (cashOut CashOut) GetState() State {
 
 // GetSum возвращает Сумму Расходного ордера в установленной валюте.
 func (cashOut CashOut) GetSum() float64 {
-	return Deref(cashOut.Sum)
+	return Deref(cashOut.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -240,7 +241,7 @@ func (cashOut CashOut) GetUpdated() time.Time {
 
 // GetVatSum возвращает Сумму НДС.
 func (cashOut CashOut) GetVatSum() float64 {
-	return Deref(cashOut.VatSum)
+	return Deref(cashOut.VatSum).Float()
 }
 
 // GetFactureOut возвращает Ссылку на выданный счет-фактуру, с которым связан этот платеж.
@@ -422,7 +423,7 @@ func (cashOut *CashOut) SetState(state *State) *CashOut {
 
 // SetSum устанавливает Сумму Расходного ордера в установленной валюте.
 func (cashOut *CashOut) SetSum(sum float64) *CashOut {
-	cashOut.Sum = &sum
+	cashOut.Sum = NewMoney(MoneyFromFloat(sum))
 	return cashOut
 }
 
@@ -485,6 +486,21 @@ type CashOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CashOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех расходных ордеров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CashOut, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех расходных ордеров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[CashOut]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех расходных ордеров асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*CashOut, error]
+
 	// Create выполняет запрос на создание расходного ордера.
 	// Обязательные поля для заполнения:
 	//	- organization (Метаданные юрлица)
@@ -497,8 +513,8 @@ type CashOutService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение расходных ордеров.
 	// Изменяемые приходные ордеры должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список приходных ордеров и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или расходных приходных ордеров.
-	CreateUpdateMany(ctx context.Context, cashOutList Slice[CashOut], params ...func(*Params)) (*Slice[CashOut], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или расходных приходных ордеров и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, cashOutList Slice[CashOut], params ...func(*Params)) (*BulkResult[CashOut], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление расходного ордера по ID.
 	// Принимает контекст и ID расходного ордера.
@@ -512,8 +528,8 @@ type CashOutService interface {
 
 	// DeleteMany выполняет запрос на массовое удаление расходных ордеров.
 	// Принимает контекст и множество расходных ордеров.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*CashOut) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*CashOut) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetMetadata выполняет запрос на получение метаданных расходных ордеров.
 	// Принимает контекст.
@@ -538,8 +554,8 @@ type CashOutService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -553,8 +569,8 @@ type CashOutService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// Template выполняет запрос на получение предзаполненного расходного ордера со стандартными полями без связи с какими-либо другими документами.
 	// Принимает контекст.
@@ -634,8 +650,8 @@ type CashOutService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -16,7 +17,7 @@ import (
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-poluchennyj-otchet-komissionera
 type CommissionReportIn struct {
-	VatSum                        *float64                                     `json:"vatSum,omitempty"`                        // Сумма НДС
+	VatSum                        *Money                                       `json:"vatSum,omitempty"`                        // Сумма НДС
 	Organization                  *Organization                                `json:"organization,omitempty"`                  // Метаданные юрлица
 	AgentAccount                  *AgentAccount                                `json:"agentAccount,omitempty"`                  // Метаданные счета контрагента
 	Agent                         *Counterparty                                `json:"agent,omitempty"`                         // Метаданные контрагента
@@ -25,7 +26,7 @@ type CommissionReportIn struct {
 	CommissionOverhead            *CommissionOverhead                          `json:"commissionOverhead,omitempty"`            // Прочие расходы. Если Позиции отчёта комиссионера не заданы, то расходы нельзя задать
 	CommissionPeriodEnd           *Timestamp                                   `json:"commissionPeriodEnd,omitempty"`           // Конец периода
 	CommissionPeriodStart         *Timestamp                                   `json:"commissionPeriodStart,omitempty"`         // Начало периода
-	CommitentSum                  *float64                                     `json:"commitentSum,omitempty"`                  // Сумма комитента в установленной валюте
+	CommitentSum                  *Money                                       `json:"commitentSum,omitempty"`                  // Сумма комитента в установленной валюте
 	Contract                      *Contract                                    `json:"contract,omitempty"`                      // Метаданные договора
 	Created                       *Timestamp                                   `json:"created,omitempty"`                       // Дата создания
 	Deleted                       *Timestamp                                   `json:"deleted,omitempty"`                       // Момент последнего удаления Полученного отчёта комиссионера
@@ -40,7 +41,7 @@ type CommissionReportIn struct {
 	Applicable                    *bool                                        `json:"applicable,omitempty"`                    // Отметка о проведении
 	OrganizationAccount           *AgentAccount                                `json:"organizationAccount,omitempty"`           // Метаданные счета юрлица
 	Owner                         *Employee                                    `json:"owner,omitempty"`                         // Метаданные владельца (Сотрудника)
-	PayedSum                      *float64                                     `json:"payedSum,omitempty"`                      // Оплаченная сумма
+	PayedSum                      *Money                                       `json:"payedSum,omitempty"`                      // Оплаченная сумма
 	Positions                     *MetaArray[CommissionReportInPosition]       `json:"positions,omitempty"`                     // Метаданные позиций реализовано комиссионером Полученного отчёта комиссионера
 	Printed                       *bool                                        `json:"printed,omitempty"`                       // Напечатан ли документ
 	Project                       *NullValue[Project]                          `json:"project,omitempty"`                       // Метаданные проекта
@@ -52,7 +53,7 @@ type CommissionReportIn struct {
 	SalesChannel                  *NullValue[SalesChannel]                     `json:"salesChannel,omitempty"`                  // Метаданные канала продаж
 	Shared                        *bool                                        `json:"shared,omitempty"`                        // Общий доступ
 	State                         *NullValue[State]                            `json:"state,omitempty"`                         // Метаданные статуса Полученного отчёта комиссионера
-	Sum                           *float64                                     `json:"sum,omitempty"`                           // Сумма Полученного отчёта комиссионера в копейках
+	Sum                           *Money                                       `json:"sum,omitempty"`                           // Сумма Полученного отчёта комиссионера в копейках
 	SyncID                        *string                                      `json:"syncId,omitempty"`                        // ID синхронизации
 	Updated                       *Timestamp                                   `json:"updated,omitempty"`                       // Момент последнего обновления Полученного отчёта комиссионера
 	VatEnabled                    *bool                                        `json:"vatEnabled,omitempty"`                    // Учитывается ли НДС
@@ -88,7 +89,7 @@ func (commissionReportIn CommissionReportIn) AsOperationIn() *Operation {
 
 // GetVatSum возвращает Сумму НДС.
 func (commissionReportIn CommissionReportIn) GetVatSum() float64 {
-	return Deref(commissionReportIn.VatSum)
+	return Deref(commissionReportIn.VatSum).Float()
 }
 
 // GetOrganization возвращает Метаданные юрлица.
@@ -138,7 +139,7 @@ func (commissionReportIn CommissionReportIn) GetCommissionPeriodStart() time.Tim
 
 // GetCommitentSum возвращает Сумму комитента в установленной валюте.
 func (commissionReportIn CommissionReportIn) GetCommitentSum() float64 {
-	return Deref(commissionReportIn.CommitentSum)
+	return Deref(commissionReportIn.CommitentSum).Float()
 }
 
 // GetContract возвращает Метаданные договора.
@@ -213,7 +214,7 @@ func (commissionReportIn CommissionReportIn) GetOwner() Employee {
 
 // GetPayedSum возвращает Оплаченную сумму.
 func (commissionReportIn CommissionReportIn) GetPayedSum() float64 {
-	return Deref(commissionReportIn.PayedSum)
+	return Deref(commissionReportIn.PayedSum).Float()
 }
 
 // GetPositions возвращает Метаданные позиций реализовано комиссионером Полученного отчёта комиссионера.
@@ -273,7 +274,7 @@ func (commissionReportIn CommissionReportIn) GetState() State {
 
 // GetSum возвращает Сумму Полученного отчёта комиссионера в копейках.
 func (commissionReportIn CommissionReportIn) GetSum() float64 {
-	return Deref(commissionReportIn.Sum)
+	return Deref(commissionReportIn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -356,7 +357,7 @@ func (commissionReportIn *CommissionReportIn) SetCommissionOverhead(commissionOv
 //
 // Если Позиции отчёта комиссионера не заданы, то расходы нельзя задать.
 func (commissionReportIn *CommissionReportIn) SetCommissionOverheadSum(sum float64) *CommissionReportIn {
-	commissionReportIn.CommissionOverhead = &CommissionOverhead{&sum}
+	commissionReportIn.CommissionOverhead = &CommissionOverhead{NewMoney(MoneyFromFloat(sum))}
 	return commissionReportIn
 }
 
@@ -573,17 +574,17 @@ func (commissionReportIn *CommissionReportIn) Delete(ctx context.Context, client
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-poluchennyj-otchet-komissionera-poluchennye-otchety-komissionera-prochie-rashody
 type CommissionOverhead struct {
-	Sum *float64 `json:"sum,omitempty"` // Сумма в копейках
+	Sum *Money `json:"sum,omitempty"` // Сумма в копейках
 }
 
 // GetSum возвращает сумму в копейках.
 func (commissionOverhead CommissionOverhead) GetSum() float64 {
-	return Deref(commissionOverhead.Sum)
+	return Deref(commissionOverhead.Sum).Float()
 }
 
 // SetSum устанавливает сумму в копейках.
 func (commissionOverhead *CommissionOverhead) SetSum(sum float64) *CommissionOverhead {
-	commissionOverhead.Sum = &sum
+	commissionOverhead.Sum = NewMoney(MoneyFromFloat(sum))
 	return commissionOverhead
 }
 
@@ -604,9 +605,9 @@ type CommissionReportInPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции.
-	Reward     *float64            `json:"reward,omitempty"`     // Вознаграждение
+	Reward     *Money              `json:"reward,omitempty"`     // Вознаграждение
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 }
@@ -633,7 +634,7 @@ func (commissionReportInPosition CommissionReportInPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (commissionReportInPosition CommissionReportInPosition) GetPrice() float64 {
-	return Deref(commissionReportInPosition.Price)
+	return Deref(commissionReportInPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -643,7 +644,7 @@ func (commissionReportInPosition CommissionReportInPosition) GetQuantity() float
 
 // GetReward возвращает Вознаграждение.
 func (commissionReportInPosition CommissionReportInPosition) GetReward() float64 {
-	return Deref(commissionReportInPosition.Reward)
+	return Deref(commissionReportInPosition.Reward).Float()
 }
 
 // GetVat возвращает НДС, которым облагается текущая позиция.
@@ -676,7 +677,7 @@ func (commissionReportInPosition *CommissionReportInPosition) SetPack(pack *Pack
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (commissionReportInPosition *CommissionReportInPosition) SetPrice(price float64) *CommissionReportInPosition {
-	commissionReportInPosition.Price = &price
+	commissionReportInPosition.Price = NewMoney(MoneyFromFloat(price))
 	return commissionReportInPosition
 }
 
@@ -688,7 +689,7 @@ func (commissionReportInPosition *CommissionReportInPosition) SetQuantity(quanti
 
 // SetReward устанавливает Вознаграждение.
 func (commissionReportInPosition *CommissionReportInPosition) SetReward(reward float64) *CommissionReportInPosition {
-	commissionReportInPosition.Reward = &reward
+	commissionReportInPosition.Reward = NewMoney(MoneyFromFloat(reward))
 	return commissionReportInPosition
 }
 
@@ -725,9 +726,9 @@ type CommissionReportInReturnPosition struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
-	Reward     *float64            `json:"reward,omitempty"`     // Вознаграждение
+	Reward     *Money              `json:"reward,omitempty"`     // Вознаграждение
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 }
@@ -749,7 +750,7 @@ func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetID()
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetPrice() float64 {
-	return Deref(commissionReportInReturnPosition.Price)
+	return Deref(commissionReportInReturnPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -759,7 +760,7 @@ func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetQuan
 
 // GetReward возвращает Вознаграждение.
 func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetReward() float64 {
-	return Deref(commissionReportInReturnPosition.Reward)
+	return Deref(commissionReportInReturnPosition.Reward).Float()
 }
 
 // GetVat возвращает НДС, которым облагается текущая позиция.
@@ -784,7 +785,7 @@ func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetAss
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetPrice(price float64) *CommissionReportInReturnPosition {
-	commissionReportInReturnPosition.Price = &price
+	commissionReportInReturnPosition.Price = NewMoney(MoneyFromFloat(price))
 	return commissionReportInReturnPosition
 }
 
@@ -796,7 +797,7 @@ func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetQua
 
 // SetReward устанавливает Вознаграждение.
 func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetReward(reward float64) *CommissionReportInReturnPosition {
-	commissionReportInReturnPosition.Reward = &reward
+	commissionReportInReturnPosition.Reward = NewMoney(MoneyFromFloat(reward))
 	return commissionReportInReturnPosition
 }
 
@@ -834,6 +835,21 @@ type CommissionReportInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CommissionReportIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех полученных отчётов комиссионера.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CommissionReportIn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех полученных отчётов комиссионера.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[CommissionReportIn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех полученных отчётов комиссионера асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*CommissionReportIn, error]
+
 	// Create выполняет запрос на создание полученного отчёта комиссионера.
 	// Обязательные поля для заполнения:
 	//	- agent (Контрагент)
@@ -850,13 +866,13 @@ type CommissionReportInService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение полученных отчётов комиссионера.
 	// Изменяемые полученные отчёты комиссионера должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список полученных отчётов комиссионера и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых полученных отчётов комиссионера.
-	CreateUpdateMany(ctx context.Context, commissionReportInList Slice[CommissionReportIn], params ...func(*Params)) (*Slice[CommissionReportIn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых полученных отчётов комиссионера и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, commissionReportInList Slice[CommissionReportIn], params ...func(*Params)) (*BulkResult[CommissionReportIn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление полученных отчётов комиссионера.
 	// Принимает контекст и множество полученных отчётов комиссионера.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*CommissionReportIn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*CommissionReportIn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление полученного отчёта комиссионера по ID.
 	// Принимает контекст и ID полученного отчёта комиссионера.
@@ -890,6 +906,11 @@ type CommissionReportInService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[CommissionReportInPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*CommissionReportInPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -907,8 +928,8 @@ type CommissionReportInService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*CommissionReportInPosition) (*Slice[CommissionReportInPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*CommissionReportInPosition) (*BulkResult[CommissionReportInPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -920,6 +941,12 @@ type CommissionReportInService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*CommissionReportInPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[CommissionReportInPosition], key func(position *CommissionReportInPosition) string) (*PositionSyncResult[CommissionReportInPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -953,8 +980,8 @@ type CommissionReportInService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -968,8 +995,8 @@ type CommissionReportInService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetBySyncID выполняет запрос на получение отдельного документа по syncID.
 	// Принимает контекст и syncID документа.
@@ -1058,8 +1085,8 @@ type CommissionReportInService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -1153,12 +1180,12 @@ func (service *commissionReportInService) UpdateReturnPosition(ctx context.Conte
 }
 
 func (service *commissionReportInService) DeleteReturnPosition(ctx context.Context, id, positionID string) (bool, *resty.Response, error) {
-	path := fmt.Sprintf(EndpointPositionsID, id, positionID)
+	path := fmt.Sprintf(EndpointCommissionReportInReturnPositionsID, id, positionID)
 	return NewRequestBuilder[any](service.client, path).Delete(ctx)
 }
 
 func (service *commissionReportInService) DeleteReturnPositionMany(ctx context.Context, id string, entities ...*CommissionReportInReturnPosition) (*DeleteManyResponse, *resty.Response, error) {
-	path := fmt.Sprintf(EndpointPositionsDelete, id)
+	path := fmt.Sprintf(EndpointCommissionReportInReturnPositions+EndpointDelete, id)
 	return NewRequestBuilder[DeleteManyResponse](service.client, path).Post(ctx, entities)
 }
 
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,11 +20,11 @@ type CommissionReportOut struct {
 	OrganizationAccount   *AgentAccount                           `json:"organizationAccount,omitempty"`   // Метаданные счета юрлица
 	AgentAccount          *AgentAccount                           `json:"agentAccount,omitempty"`          // Метаданные счета контрагента
 	Organization          *Organization                           `json:"organization,omitempty"`          // Метаданные юрлица
-	VatSum                *float64                                `json:"vatSum,omitempty"`                // Сумма НДС
+	VatSum                *Money                                  `json:"vatSum,omitempty"`                // Сумма НДС
 	Code                  *string                                 `json:"code,omitempty"`                  // Код Выданного отчета комиссионера
 	CommissionPeriodEnd   *Timestamp                              `json:"commissionPeriodEnd,omitempty"`   // Конец периода
 	Agent                 *Counterparty                           `json:"agent,omitempty"`                 // Метаданные контрагента
-	CommitentSum          *float64                                `json:"commitentSum,omitempty"`          // Сумма коммитента в установленной валюте
+	CommitentSum          *Money                                  `json:"commitentSum,omitempty"`          // Сумма коммитента в установленной валюте
 	Contract              *Contract                               `json:"contract,omitempty"`              // Метаданные договора
 	Created               *Timestamp                              `json:"created,omitempty"`               // Дата создания
 	Deleted               *Timestamp                              `json:"deleted,omitempty"`               // Момент последнего удаления Выданного отчета комиссионера
@@ -38,7 +39,7 @@ type CommissionReportOut struct {
 	AccountID             *string                                 `json:"accountId,omitempty"`             // ID учётной записи
 	CommissionPeriodStart *Timestamp                              `json:"commissionPeriodStart,omitempty"` // Начало периода
 	Owner                 *Employee                               `json:"owner,omitempty"`                 // Метаданные владельца (Сотрудника)
-	PayedSum              *float64                                `json:"payedSum,omitempty"`              // Оплаченная сумма
+	PayedSum              *Money                                  `json:"payedSum,omitempty"`              // Оплаченная сумма
 	Positions             *MetaArray[CommissionReportOutPosition] `json:"positions,omitempty"`             // Метаданные позиций Выданного отчета
 	Printed               *bool                                   `json:"printed,omitempty"`               // Напечатан ли документ
 	Project               *NullValue[Project]                     `json:"project,omitempty"`               // Метаданные проекта
@@ -49,7 +50,7 @@ type CommissionReportOut struct {
 	SalesChannel          *NullValue[SalesChannel]                `json:"salesChannel,omitempty"`          // Метаданные канала продаж
 	Shared                *bool                                   `json:"shared,omitempty"`                // Общий доступ
 	State                 *NullValue[State]                       `json:"state,omitempty"`                 // Метаданные статуса Выданного отчета комиссионера
-	Sum                   *float64                                `json:"sum,omitempty"`                   // Сумма Выданного отчета комиссионера в копейках
+	Sum                   *Money                                  `json:"sum,omitempty"`                   // Сумма Выданного отчета комиссионера в копейках
 	SyncID                *string                                 `json:"syncId,omitempty"`                // ID синхронизации
 	Updated               *Timestamp                              `json:"updated,omitempty"`               // Момент последнего обновления Выданного отчета комиссионера
 	VatEnabled            *bool                                   `json:"vatEnabled,omitempty"`            // Учитывается ли НДС
@@ -105,7 +106,7 @@ func (commissionReportOut CommissionReportOut) GetOrganization() Organization {
 
 // GetVatSum возвращает Сумму НДС.
 func (commissionReportOut CommissionReportOut) GetVatSum() float64 {
-	return Deref(commissionReportOut.VatSum)
+	return Deref(commissionReportOut.VatSum).Float()
 }
 
 // GetCode возвращает Код Выданного отчета комиссионера.
@@ -125,7 +126,7 @@ func (commissionReportOut CommissionReportOut) GetAgent() Counterparty {
 
 // GetCommitentSum возвращает Сумму комитента в установленной валюте.
 func (commissionReportOut CommissionReportOut) GetCommitentSum() float64 {
-	return Deref(commissionReportOut.CommitentSum)
+	return Deref(commissionReportOut.CommitentSum).Float()
 }
 
 // GetContract возвращает Метаданные договора.
@@ -200,7 +201,7 @@ func (commissionReportOut CommissionReportOut) GetOwner() Employee {
 
 // GetPayedSum возвращает Оплаченную сумму.
 func (commissionReportOut CommissionReportOut) GetPayedSum() float64 {
-	return Deref(commissionReportOut.PayedSum)
+	return Deref(commissionReportOut.PayedSum).Float()
 }
 
 // GetPositions возвращает Метаданные позиций Выданного отчёта.
@@ -255,7 +256,7 @@ func (commissionReportOut CommissionReportOut) GetState() State {
 
 // GetSum возвращает Сумму Выданного отчёта комиссионера в копейках.
 func (commissionReportOut CommissionReportOut) GetSum() float64 {
-	return Deref(commissionReportOut.Sum)
+	return Deref(commissionReportOut.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -535,9 +536,9 @@ type CommissionReportOutPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-	Reward     *float64            `json:"reward,omitempty"`     // Вознаграждение
+	Reward     *Money              `json:"reward,omitempty"`     // Вознаграждение
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 }
@@ -564,7 +565,7 @@ func (commissionReportOutPosition CommissionReportOutPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (commissionReportOutPosition CommissionReportOutPosition) GetPrice() float64 {
-	return Deref(commissionReportOutPosition.Price)
+	return Deref(commissionReportOutPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -574,7 +575,7 @@ func (commissionReportOutPosition CommissionReportOutPosition) GetQuantity() flo
 
 // GetReward возвращает Вознаграждение.
 func (commissionReportOutPosition CommissionReportOutPosition) GetReward() float64 {
-	return Deref(commissionReportOutPosition.Reward)
+	return Deref(commissionReportOutPosition.Reward).Float()
 }
 
 // GetVat возвращает НДС, которым облагается текущая позиция.
@@ -607,7 +608,7 @@ func (commissionReportOutPosition *CommissionReportOutPosition) SetPack(pack *Pa
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (commissionReportOutPosition *CommissionReportOutPosition) SetPrice(price *float64) *CommissionReportOutPosition {
-	commissionReportOutPosition.Price = price
+	commissionReportOutPosition.Price = moneyFromFloatPtr(price)
 	return commissionReportOutPosition
 }
 
@@ -619,7 +620,7 @@ func (commissionReportOutPosition *CommissionReportOutPosition) SetQuantity(quan
 
 // SetReward устанавливает Вознаграждение.
 func (commissionReportOutPosition *CommissionReportOutPosition) SetReward(reward *float64) *CommissionReportOutPosition {
-	commissionReportOutPosition.Reward = reward
+	commissionReportOutPosition.Reward = moneyFromFloatPtr(reward)
 	return commissionReportOutPosition
 }
 
@@ -657,6 +658,21 @@ type CommissionReportOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CommissionReportOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех выданных отчётов комиссионера.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CommissionReportOut, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех выданных отчётов комиссионера.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[CommissionReportOut]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех выданных отчётов комиссионера асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*CommissionReportOut, error]
+
 	// Create выполняет запрос на создание выданного отчёта комиссионера.
 	// Обязательные поля для заполнения:
 	//	- agent (Контрагент)
@@ -673,13 +689,13 @@ type CommissionReportOutService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение выданных отчётов комиссионера.
 	// Изменяемые выданные отчёты комиссионера должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список выданных отчётов комиссионера и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых выданных отчётов комиссионера.
-	CreateUpdateMany(ctx context.Context, commissionReportOutList Slice[CommissionReportOut], params ...func(*Params)) (*Slice[CommissionReportOut], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых выданных отчётов комиссионера и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, commissionReportOutList Slice[CommissionReportOut], params ...func(*Params)) (*BulkResult[CommissionReportOut], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление выданных отчётов комиссионера.
 	// Принимает контекст и множество выданных отчётов комиссионера.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*CommissionReportOut) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*CommissionReportOut) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление выданного отчёта комиссионера по ID.
 	// Принимает контекст и ID выданного отчёта комиссионера.
@@ -713,6 +729,11 @@ type CommissionReportOutService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[CommissionReportOutPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*CommissionReportOutPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -730,8 +751,8 @@ type CommissionReportOutService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*CommissionReportOutPosition) (*Slice[CommissionReportOutPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*CommissionReportOutPosition) (*BulkResult[CommissionReportOutPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -743,6 +764,12 @@ type CommissionReportOutService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*CommissionReportOutPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[CommissionReportOutPosition], key func(position *CommissionReportOutPosition) string) (*PositionSyncResult[CommissionReportOutPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -776,8 +803,8 @@ type CommissionReportOutService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -791,8 +818,8 @@ type CommissionReportOutService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetBySyncID выполняет запрос на получение отдельного документа по syncID.
 	// Принимает контекст и syncID документа.
@@ -856,8 +883,8 @@ type CommissionReportOutService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
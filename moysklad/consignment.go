@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -227,6 +228,21 @@ type ConsignmentService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Consignment], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех серий.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Consignment, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех серий.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Consignment]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех серий асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Consignment, error]
+
 	// Create выполняет запрос на создание серии.
 	// Обязательные поля для заполнения:
 	//	- label (Метка Серии)
@@ -238,13 +254,13 @@ type ConsignmentService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение серий.
 	// Изменяемые серии должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список серий и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых серий.
-	CreateUpdateMany(ctx context.Context, consignmentList Slice[Consignment], params ...func(*Params)) (*Slice[Consignment], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых серий и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, consignmentList Slice[Consignment], params ...func(*Params)) (*BulkResult[Consignment], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление серий.
 	// Принимает контекст и множество серий.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Consignment) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Consignment) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление серии по ID.
 	// Принимает контекст и ID серии.
@@ -289,8 +305,8 @@ type ConsignmentService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -304,8 +320,8 @@ type ConsignmentService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetNamedFilterList выполняет запрос на получение списка фильтров.
 	// Принимает контекст и опционально объект параметров запроса Params.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -37,7 +38,7 @@ type Contract struct {
 	Updated             *Timestamp        `json:"updated,omitempty"`             // Момент последнего обновления сущности
 	Shared              *bool             `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State] `json:"state,omitempty"`               // Метаданные статуса договора
-	Sum                 *float64          `json:"sum,omitempty"`                 // Сумма Договора
+	Sum                 *Money            `json:"sum,omitempty"`                 // Сумма Договора
 	SyncID              *string           `json:"syncId,omitempty"`              // ID синхронизации
 	ContractType        ContractType      `json:"contractType,omitempty"`        // Тип Договора
 	RewardType          RewardType        `json:"rewardType,omitempty"`          // Тип Вознаграждения
@@ -166,7 +167,7 @@ func (contract Contract) GetState() State {
 
 // GetSum возвращает Сумму Договора.
 func (contract Contract) GetSum() float64 {
-	return Deref(contract.Sum)
+	return Deref(contract.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -309,7 +310,7 @@ func (contract *Contract) SetState(state *State) *Contract {
 
 // SetSum устанавливает Сумму Договора.
 func (contract *Contract) SetSum(sum *float64) *Contract {
-	contract.Sum = sum
+	contract.Sum = moneyFromFloatPtr(sum)
 	return contract
 }
 
@@ -424,6 +425,21 @@ type ContractService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Contract], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех договоров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Contract, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех договоров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Contract]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех договоров асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Contract, error]
+
 	// Create выполняет запрос на создание договора.
 	// Обязательные поля для заполнения:
 	//	- name (Номер договора)
@@ -436,13 +452,13 @@ type ContractService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение договоров.
 	// Изменяемые договоры должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список договоров и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых договоров.
-	CreateUpdateMany(ctx context.Context, contractList Slice[Contract], params ...func(*Params)) (*Slice[Contract], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых договоров и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, contractList Slice[Contract], params ...func(*Params)) (*BulkResult[Contract], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление договоров.
 	// Принимает контекст и множество договоров.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Contract) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Contract) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление договора.
 	// Принимает контекст и ID договора.
@@ -487,8 +503,8 @@ type ContractService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -502,8 +518,8 @@ type ContractService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -557,8 +573,8 @@ type ContractService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -823,6 +824,16 @@ type CounterpartyService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Counterparty], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех контрагентов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Counterparty, error]
+
+	// GetListAllAsync возвращает итератор для получения всех контрагентов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Counterparty, error]
+
 	// Create выполняет запрос на создание контрагента.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование контрагента)
@@ -833,13 +844,13 @@ type CounterpartyService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или контрагентов.
 	// Изменяемые контрагенты должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список контрагентов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых контрагентов.
-	CreateUpdateMany(ctx context.Context, counterpartyList Slice[Counterparty], params ...func(*Params)) (*Slice[Counterparty], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых контрагентов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, counterpartyList Slice[Counterparty], params ...func(*Params)) (*BulkResult[Counterparty], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление контрагентов.
 	// Принимает контекст и множество контрагентов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Counterparty) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Counterparty) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление контрагента.
 	// Принимает контекст и ID контрагента.
@@ -884,8 +895,8 @@ type CounterpartyService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -899,8 +910,8 @@ type CounterpartyService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetSettings выполняет запрос на получение настроек справочника контрагентов.
 	// Принимает контекст.
@@ -1051,10 +1062,6 @@ type counterpartyService struct {
 	endpointFiles
 }
 
-func (service *counterpartyService) GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Counterparty]], *resty.Response, error) {
-	return NewRequestBuilder[List[Counterparty]](service.client, service.uri).SetParams(params).Async(ctx)
-}
-
 func (service *counterpartyService) GetContactPersonList(ctx context.Context, id string, params ...func(*Params)) (*List[ContactPerson], *resty.Response, error) {
 	path := fmt.Sprintf(EndpointCounterpartyContactPersons, id)
 	return NewRequestBuilder[List[ContactPerson]](service.client, path).SetParams(params).Get(ctx)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -34,7 +35,7 @@ type CounterpartyAdjustment struct {
 	ID           *string          `json:"id,omitempty"`           // ID Корректировки взаиморасчетов
 	Published    *bool            `json:"published,omitempty"`    // Опубликован ли документ
 	Shared       *bool            `json:"shared,omitempty"`       // Общий доступ
-	Sum          *float64         `json:"sum,omitempty"`          // Сумма Корректировки взаиморасчетов в копейках
+	Sum          *Money           `json:"sum,omitempty"`          // Сумма Корректировки взаиморасчетов в копейках
 	Attributes   Slice[Attribute] `json:"attributes,omitempty"`   // Список метаданных доп. полей
 }
 
@@ -150,7 +151,7 @@ func (counterPartyAdjustment CounterpartyAdjustment) GetShared() bool {
 
 // GetSum возвращает Сумму Корректировки взаиморасчетов в копейках.
 func (counterPartyAdjustment CounterpartyAdjustment) GetSum() float64 {
-	return Deref(counterPartyAdjustment.Sum)
+	return Deref(counterPartyAdjustment.Sum).Float()
 }
 
 // GetAttributes возвращает Список метаданных доп. полей.
@@ -285,6 +286,21 @@ type CounterPartyAdjustmentService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CounterpartyAdjustment], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех корректировок взаиморасчётов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CounterpartyAdjustment, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех корректировок взаиморасчётов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[CounterpartyAdjustment]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех корректировок взаиморасчётов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*CounterpartyAdjustment, error]
+
 	// Create выполняет запрос на создание корректировки взаиморасчётов.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -296,13 +312,13 @@ type CounterPartyAdjustmentService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение корректировок взаиморасчётов.
 	// Изменяемые корректировки взаиморасчётов должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список корректировок взаиморасчётов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых корректировок взаиморасчётов.
-	CreateUpdateMany(ctx context.Context, counterPartyAdjustmentList Slice[CounterpartyAdjustment], params ...func(*Params)) (*Slice[CounterpartyAdjustment], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых корректировок взаиморасчётов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, counterPartyAdjustmentList Slice[CounterpartyAdjustment], params ...func(*Params)) (*BulkResult[CounterpartyAdjustment], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление корректировок взаиморасчётов.
 	// Принимает контекст и множество корректировок взаиморасчётов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*CounterpartyAdjustment) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*CounterpartyAdjustment) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление корректировки взаиморасчётов по ID.
 	// Принимает контекст и ID корректировки взаиморасчётов.
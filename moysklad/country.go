@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -182,6 +183,21 @@ type CountryService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Country], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех стран.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Country, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех стран.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Country]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех стран асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Country, error]
+
 	// Create выполняет запрос на создание страны.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование страны)
@@ -192,13 +208,13 @@ type CountryService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение стран.
 	// Изменяемые страны должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список стран и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых стран.
-	CreateUpdateMany(ctx context.Context, countryList Slice[Country], params ...func(*Params)) (*Slice[Country], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых стран и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, countryList Slice[Country], params ...func(*Params)) (*BulkResult[Country], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление стран.
 	// Принимает контекст и множество стран.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Country) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Country) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление страны по ID.
 	// Принимает контекст и ID страны.
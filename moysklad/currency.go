@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Currency Валюта.
@@ -315,6 +316,21 @@ type CurrencyService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Currency], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех валют.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Currency, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех валют.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Currency]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех валют асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Currency, error]
+
 	// Create выполняет запрос на создание валюты.
 	// Обязательные поля для заполнения:
 	//	- name (Краткое наименование Валюты)
@@ -327,13 +343,13 @@ type CurrencyService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение валют.
 	// Изменяемые валюты должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список валют и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых валют.
-	CreateUpdateMany(ctx context.Context, currencyList Slice[Currency], params ...func(*Params)) (*Slice[Currency], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых валют и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, currencyList Slice[Currency], params ...func(*Params)) (*BulkResult[Currency], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление валют.
 	// Принимает контекст и множество валют.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Currency) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Currency) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление валюты по ID.
 	// Принимает контекст и ID валюты.
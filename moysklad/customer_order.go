@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 	"net/http"
 
 	"time"
@@ -32,33 +33,33 @@ type CustomerOrder struct {
 	Files                 *MetaArray[File]                  `json:"files,omitempty"`                 // Метаданные массива Файлов (Максимальное количество файлов - 100)
 	Group                 *Group                            `json:"group,omitempty"`                 // Отдел сотрудника
 	ID                    *string                           `json:"id,omitempty"`                    // ID Заказа покупателя
-	InvoicedSum           *float64                          `json:"invoicedSum,omitempty"`           // Сумма счетов покупателю
+	InvoicedSum           *Money                            `json:"invoicedSum,omitempty"`           // Сумма счетов покупателю
 	Meta                  *Meta                             `json:"meta,omitempty"`                  // Метаданные Заказа покупателя
 	Name                  *string                           `json:"name,omitempty"`                  // Наименование Заказа покупателя
 	Moment                *Timestamp                        `json:"moment,omitempty"`                // Дата документа
 	Organization          *Organization                     `json:"organization,omitempty"`          // Метаданные юрлица
 	Printed               *bool                             `json:"printed,omitempty"`               // Напечатан ли документ
 	Owner                 *Employee                         `json:"owner,omitempty"`                 // Метаданные владельца (Сотрудника)
-	PayedSum              *float64                          `json:"payedSum,omitempty"`              // Сумма входящих платежей по Заказу
+	PayedSum              *Money                            `json:"payedSum,omitempty"`              // Сумма входящих платежей по Заказу
 	Positions             *MetaArray[CustomerOrderPosition] `json:"positions,omitempty"`             // Метаданные позиций Заказа покупателя
 	AccountID             *string                           `json:"accountId,omitempty"`             // ID учётной записи
 	Contract              *NullValue[Contract]              `json:"contract,omitempty"`              // Метаданные договора
 	Published             *bool                             `json:"published,omitempty"`             // Опубликован ли документ
 	Rate                  *NullValue[Rate]                  `json:"rate,omitempty"`                  // Валюта
-	ReservedSum           *float64                          `json:"reservedSum,omitempty"`           // Сумма товаров в резерве
+	ReservedSum           *Money                            `json:"reservedSum,omitempty"`           // Сумма товаров в резерве
 	SalesChannel          *NullValue[SalesChannel]          `json:"salesChannel,omitempty"`          // Метаданные канала продаж
 	Shared                *bool                             `json:"shared,omitempty"`                // Общий доступ
 	ShipmentAddress       *string                           `json:"shipmentAddress,omitempty"`       // Адрес доставки Заказа покупателя
 	ShipmentAddressFull   *Address                          `json:"shipmentAddressFull,omitempty"`   // Адрес доставки Заказа покупателя с детализацией по отдельным полям
-	ShippedSum            *float64                          `json:"shippedSum,omitempty"`            // Сумма отгруженного
+	ShippedSum            *Money                            `json:"shippedSum,omitempty"`            // Сумма отгруженного
 	State                 *NullValue[State]                 `json:"state,omitempty"`                 // Метаданные статуса заказа
 	Store                 *NullValue[Store]                 `json:"store,omitempty"`                 // Метаданные склада
-	Sum                   *float64                          `json:"sum,omitempty"`                   // Сумма Заказа в установленной валюте
+	Sum                   *Money                            `json:"sum,omitempty"`                   // Сумма Заказа в установленной валюте
 	SyncID                *string                           `json:"syncId,omitempty"`                // ID синхронизации
 	Updated               *Timestamp                        `json:"updated,omitempty"`               // Момент последнего обновления Заказа покупателя
 	VatEnabled            *bool                             `json:"vatEnabled,omitempty"`            // Учитывается ли НДС
 	VatIncluded           *bool                             `json:"vatIncluded,omitempty"`           // Включен ли НДС в цену
-	VatSum                *float64                          `json:"vatSum,omitempty"`                // Сумма НДС
+	VatSum                *Money                            `json:"vatSum,omitempty"`                // Сумма НДС
 	Prepayments           Slice[Prepayment]                 `json:"prepayments,omitempty"`           // Массив ссылок на связанные предоплаты
 	PurchaseOrders        Slice[PurchaseOrder]              `json:"purchaseOrders,omitempty"`        // Массив ссылок на связанные заказы поставщикам
 	Demands               Slice[Demand]                     `json:"demands,omitempty"`               // Массив ссылок на связанные отгрузки
@@ -88,6 +89,13 @@ func (customerOrder CustomerOrder) AsTaskOperation() *TaskOperation {
 	return &TaskOperation{Meta: customerOrder.Meta}
 }
 
+// CalculateTotals реализует интерфейс [TotalsCalculator].
+//
+// Рассчитывает суммы позиций и документа локально, без запроса к серверу (см. [CalculateTotals]).
+func (customerOrder CustomerOrder) CalculateTotals() (*DocumentTotals, error) {
+	return CalculateTotals(customerOrder.GetPositions().Rows, customerOrder.GetVatEnabled(), customerOrder.GetVatIncluded())
+}
+
 // AsOperationIn реализует интерфейс [OperationInConverter].
 func (commissionReportOut CommissionReportOut) AsOperationIn() *Operation {
 	return commissionReportOut.AsOperation()
@@ -170,7 +178,7 @@ func (customerOrder CustomerOrder) GetID() string {
 
 // GetInvoicedSum возвращает Сумму счетов покупателю.
 func (customerOrder CustomerOrder) GetInvoicedSum() float64 {
-	return Deref(customerOrder.InvoicedSum)
+	return Deref(customerOrder.InvoicedSum).Float()
 }
 
 // GetMeta возвращает Метаданные Заказа покупателя.
@@ -205,7 +213,7 @@ func (customerOrder CustomerOrder) GetOwner() Employee {
 
 // GetPayedSum возвращает Оплаченную сумму.
 func (customerOrder CustomerOrder) GetPayedSum() float64 {
-	return Deref(customerOrder.PayedSum)
+	return Deref(customerOrder.PayedSum).Float()
 }
 
 // GetPositions возвращает Метаданные позиций Заказа покупателя.
@@ -235,7 +243,7 @@ func (customerOrder CustomerOrder) GetRate() Rate {
 
 // GetReservedSum возвращает Сумму товаров в резерве.
 func (customerOrder CustomerOrder) GetReservedSum() float64 {
-	return Deref(customerOrder.ReservedSum)
+	return Deref(customerOrder.ReservedSum).Float()
 }
 
 // GetSalesChannel возвращает Метаданные канала продаж.
@@ -260,7 +268,7 @@ func (customerOrder CustomerOrder) GetShipmentAddressFull() Address {
 
 // GetShippedSum возвращает Сумму отгруженного.
 func (customerOrder CustomerOrder) GetShippedSum() float64 {
-	return Deref(customerOrder.ShippedSum)
+	return Deref(customerOrder.ShippedSum).Float()
 }
 
 // GetState возвращает Метаданные статуса Заказа покупателя.
@@ -275,7 +283,7 @@ func (customerOrder CustomerOrder) GetStore() Store {
 
 // GetSum возвращает Сумму Заказа в установленной валюте.
 func (customerOrder CustomerOrder) GetSum() float64 {
-	return Deref(customerOrder.Sum)
+	return Deref(customerOrder.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -305,7 +313,7 @@ func (customerOrder CustomerOrder) GetVatIncluded() bool {
 
 // GetVatSum возвращает Сумму НДС.
 func (customerOrder CustomerOrder) GetVatSum() float64 {
-	return Deref(customerOrder.VatSum)
+	return Deref(customerOrder.VatSum).Float()
 }
 
 // GetPurchaseOrders возвращает Массив ссылок на связанные заказы поставщикам.
@@ -640,7 +648,7 @@ type CustomerOrderPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Reserve    *float64            `json:"reserve,omitempty"`    // Резерв данной позиции
 	Shipped    *float64            `json:"shipped,omitempty"`    // Доставлено
@@ -680,7 +688,7 @@ func (customerOrderPosition CustomerOrderPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (customerOrderPosition CustomerOrderPosition) GetPrice() float64 {
-	return Deref(customerOrderPosition.Price)
+	return Deref(customerOrderPosition.Price).Float()
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -708,6 +716,11 @@ func (customerOrderPosition CustomerOrderPosition) GetVatEnabled() bool {
 	return Deref(customerOrderPosition.VatEnabled)
 }
 
+// LookupVatEnabled возвращает флаг учёта НДС и true, если флаг указан.
+func (customerOrderPosition CustomerOrderPosition) LookupVatEnabled() (bool, bool) {
+	return Deref(customerOrderPosition.VatEnabled), customerOrderPosition.VatEnabled != nil
+}
+
 // GetStock возвращает Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`).
 func (customerOrderPosition CustomerOrderPosition) GetStock() Stock {
 	return Deref(customerOrderPosition.Stock)
@@ -750,7 +763,7 @@ func (customerOrderPosition *CustomerOrderPosition) SetPack(pack *Pack) *Custome
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (customerOrderPosition *CustomerOrderPosition) SetPrice(price float64) *CustomerOrderPosition {
-	customerOrderPosition.Price = &price
+	customerOrderPosition.Price = NewMoney(MoneyFromFloat(price))
 	return customerOrderPosition
 }
 
@@ -840,6 +853,21 @@ type CustomerOrderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CustomerOrder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех заказов покупателей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CustomerOrder, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех заказов покупателей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[CustomerOrder]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех заказов покупателей асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*CustomerOrder, error]
+
 	// Create выполняет запрос на создание заказа покупателя.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -851,13 +879,13 @@ type CustomerOrderService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение заказов покупателей.
 	// Изменяемые заказы покупателей должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список заказов покупателей и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых заказов покупателей.
-	CreateUpdateMany(ctx context.Context, customerOrderList Slice[CustomerOrder], params ...func(*Params)) (*Slice[CustomerOrder], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых заказов покупателей и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, customerOrderList Slice[CustomerOrder], params ...func(*Params)) (*BulkResult[CustomerOrder], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление заказов покупателей.
 	// Принимает контекст и множество заказов покупателей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*CustomerOrder) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*CustomerOrder) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление заказа покупателя по ID.
 	// Принимает контекст и ID заказа покупателя.
@@ -891,6 +919,11 @@ type CustomerOrderService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[CustomerOrderPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*CustomerOrderPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -908,8 +941,8 @@ type CustomerOrderService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*CustomerOrderPosition) (*Slice[CustomerOrderPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*CustomerOrderPosition) (*BulkResult[CustomerOrderPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -921,6 +954,12 @@ type CustomerOrderService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*CustomerOrderPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[CustomerOrderPosition], key func(position *CustomerOrderPosition) string) (*PositionSyncResult[CustomerOrderPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -954,8 +993,8 @@ type CustomerOrderService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -969,8 +1008,8 @@ type CustomerOrderService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -1054,8 +1093,8 @@ type CustomerOrderService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -1157,8 +1196,10 @@ func (service *customerOrderService) UpdateNote(ctx context.Context, id string,
 func (service *customerOrderService) DeleteNoteByID(ctx context.Context, id string, noteID string) (bool, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointCustomerOrderNotesID, id, noteID)
 	_, resp, err := NewRequestBuilder[EventNote](service.client, path).Delete(ctx)
-
-	return resp.StatusCode() == http.StatusNoContent, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusNoContent, resp, nil
 }
 
 // NewCustomerOrderService принимает [Client] и возвращает сервис для работы с заказами покупателя.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -35,7 +36,7 @@ type Demand struct {
 	OrganizationAccount     *AgentAccount              `json:"organizationAccount,omitempty"`     // Метаданные счета юрлица
 	Overhead                *Overhead                  `json:"overhead,omitempty"`                // Накладные расходы. Если Позиции Отгрузки не заданы, то накладные расходы нельзя задать
 	Owner                   *Employee                  `json:"owner,omitempty"`                   // Метаданные владельца (Сотрудника)
-	PayedSum                *float64                   `json:"payedSum,omitempty"`                // Сумма входящих платежей по Отгрузке
+	PayedSum                *Money                     `json:"payedSum,omitempty"`                // Сумма входящих платежей по Отгрузке
 	Positions               *MetaArray[DemandPosition] `json:"positions,omitempty"`               // Метаданные позиций Отгрузки
 	Printed                 *bool                      `json:"printed,omitempty"`                 // Напечатан ли документ
 	Project                 *NullValue[Project]        `json:"project,omitempty"`                 // Метаданные проекта
@@ -47,12 +48,12 @@ type Demand struct {
 	ShipmentAddressFull     *Address                   `json:"shipmentAddressFull,omitempty"`     // Адрес доставки Отгрузки с детализацией по отдельным полям.
 	State                   *NullValue[State]          `json:"state,omitempty"`                   // Метаданные статуса Отгрузки
 	Store                   *Store                     `json:"store,omitempty"`                   // Метаданные склада
-	Sum                     *float64                   `json:"sum,omitempty"`                     // Сумма Отгрузки в копейках
+	Sum                     *Money                     `json:"sum,omitempty"`                     // Сумма Отгрузки в копейках
 	SyncID                  *string                    `json:"syncId,omitempty"`                  // ID синхронизации
 	Updated                 *Timestamp                 `json:"updated,omitempty"`                 // Момент последнего обновления Отгрузки
 	VatEnabled              *bool                      `json:"vatEnabled,omitempty"`              // Учитывается ли НДС
 	VatIncluded             *bool                      `json:"vatIncluded,omitempty"`             // Включен ли НДС в цену
-	VatSum                  *float64                   `json:"vatSum,omitempty"`                  // Сумма НДС
+	VatSum                  *Money                     `json:"vatSum,omitempty"`                  // Сумма НДС
 	CustomerOrder           *CustomerOrder             `json:"customerOrder,omitempty"`           // Ссылка на Заказ Покупателя, с которым связана эта Отгрузка
 	FactureOut              *FactureOut                `json:"factureOut,omitempty"`              // Ссылка на Счет-фактуру выданный, с которым связана эта Отгрузка
 	Returns                 Slice[SalesReturn]         `json:"returns,omitempty"`                 // Массив ссылок на связанные возвраты
@@ -89,6 +90,13 @@ func (demand Demand) AsTaskOperation() *TaskOperation {
 	return &TaskOperation{Meta: demand.Meta}
 }
 
+// CalculateTotals реализует интерфейс [TotalsCalculator].
+//
+// Рассчитывает суммы позиций и документа локально, без запроса к серверу (см. [CalculateTotals]).
+func (demand Demand) CalculateTotals() (*DocumentTotals, error) {
+	return CalculateTotals(demand.GetPositions().Rows, demand.GetVatEnabled(), demand.GetVatIncluded())
+}
+
 // AsOperationIn реализует интерфейс [OperationInConverter].
 func (demand Demand) AsOperationIn() *Operation {
 	return demand.AsOperation()
@@ -196,7 +204,7 @@ func (demand Demand) GetOwner() Employee {
 
 // GetPayedSum возвращает Оплаченную сумму.
 func (demand Demand) GetPayedSum() float64 {
-	return Deref(demand.PayedSum)
+	return Deref(demand.PayedSum).Float()
 }
 
 // GetPositions возвращает Метаданные позиций Отгрузки.
@@ -256,7 +264,7 @@ func (demand Demand) GetStore() Store {
 
 // GetSum возвращает Сумму Отгрузки в копейках.
 func (demand Demand) GetSum() float64 {
-	return Deref(demand.Sum)
+	return Deref(demand.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -281,7 +289,7 @@ func (demand Demand) GetVatIncluded() bool {
 
 // GetVatSum возвращает Сумму НДС.
 func (demand Demand) GetVatSum() float64 {
-	return Deref(demand.VatSum)
+	return Deref(demand.VatSum).Float()
 }
 
 // GetCustomerOrder возвращает Ссылку на Заказ Покупателя, с которым связана эта Отгрузка.
@@ -694,8 +702,8 @@ func (demand *Demand) Delete(ctx context.Context, client *Client) (bool, *resty.
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-otgruzka-otgruzki-pozicii-otgruzki
 type DemandPosition struct {
 	Slot              *Slot               `json:"slot,omitempty"`               // Ячейка на складе
-	Price             *float64            `json:"price,omitempty"`              // Цена товара/услуги в копейках
-	Cost              *float64            `json:"cost,omitempty"`               // Себестоимость (только для услуг)
+	Price             *Money              `json:"price,omitempty"`              // Цена товара/услуги в копейках
+	Cost              *Money              `json:"cost,omitempty"`               // Себестоимость (только для услуг)
 	Discount          *float64            `json:"discount,omitempty"`           // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	AccountID         *string             `json:"accountId,omitempty"`          // ID учётной записи
 	Pack              *Pack               `json:"pack,omitempty"`               // Упаковка Товара
@@ -705,7 +713,7 @@ type DemandPosition struct {
 	Stock             *Stock              `json:"stock,omitempty"`              // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	VatEnabled        *bool               `json:"vatEnabled,omitempty"`         // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Vat               *int                `json:"vat,omitempty"`                // НДС, которым облагается текущая позиция
-	Overhead          *float64            `json:"overhead,omitempty"`           // Накладные расходы
+	Overhead          *Money              `json:"overhead,omitempty"`           // Накладные расходы
 	TrackingCodes1162 Slice[TrackingCode] `json:"trackingCodes_1162,omitempty"` // Коды маркировки товаров в формате тега 1162
 	TrackingCodes     Slice[TrackingCode] `json:"trackingCodes,omitempty"`      // Коды маркировки товаров и транспортных упаковок
 	Things            Slice[string]       `json:"things,omitempty"`             // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута
@@ -723,7 +731,7 @@ func (demandPosition DemandPosition) GetAssortment() AssortmentPosition {
 
 // GetCost возвращает Себестоимость (только для услуг).
 func (demandPosition DemandPosition) GetCost() float64 {
-	return Deref(demandPosition.Cost)
+	return Deref(demandPosition.Cost).Float()
 }
 
 // GetDiscount возвращает Процент скидки или наценки.
@@ -745,7 +753,7 @@ func (demandPosition DemandPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (demandPosition DemandPosition) GetPrice() float64 {
-	return Deref(demandPosition.Price)
+	return Deref(demandPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в позиции.
@@ -781,7 +789,7 @@ func (demandPosition DemandPosition) GetTrackingCodes1162() Slice[TrackingCode]
 
 // GetOverhead возвращает Накладные расходы.
 func (demandPosition DemandPosition) GetOverhead() float64 {
-	return Deref(demandPosition.Overhead)
+	return Deref(demandPosition.Overhead).Float()
 }
 
 // GetVat возвращает НДС, которым облагается текущая позиция.
@@ -798,6 +806,11 @@ func (demandPosition DemandPosition) GetVatEnabled() bool {
 	return Deref(demandPosition.VatEnabled)
 }
 
+// LookupVatEnabled возвращает флаг учёта НДС и true, если флаг указан.
+func (demandPosition DemandPosition) LookupVatEnabled() (bool, bool) {
+	return Deref(demandPosition.VatEnabled), demandPosition.VatEnabled != nil
+}
+
 // GetStock возвращает Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`).
 func (demandPosition DemandPosition) GetStock() Stock {
 	return Deref(demandPosition.Stock)
@@ -815,7 +828,7 @@ func (demandPosition *DemandPosition) SetAssortment(assortment AssortmentConvert
 
 // SetCost устанавливает Себестоимость (только для услуг).
 func (demandPosition *DemandPosition) SetCost(cost float64) *DemandPosition {
-	demandPosition.Cost = &cost
+	demandPosition.Cost = NewMoney(MoneyFromFloat(cost))
 	return demandPosition
 }
 
@@ -837,7 +850,7 @@ func (demandPosition *DemandPosition) SetPack(pack *Pack) *DemandPosition {
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (demandPosition *DemandPosition) SetPrice(price float64) *DemandPosition {
-	demandPosition.Price = &price
+	demandPosition.Price = NewMoney(MoneyFromFloat(price))
 	return demandPosition
 }
 
@@ -939,6 +952,21 @@ type DemandService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Demand], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех отгрузок.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Demand, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех отгрузок.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Demand]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех отгрузок асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Demand, error]
+
 	// Create выполняет запрос на создание отгрузки.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -951,13 +979,13 @@ type DemandService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение отгрузок.
 	// Изменяемые отгрузки должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список отгрузок и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых отгрузок.
-	CreateUpdateMany(ctx context.Context, demandList Slice[Demand], params ...func(*Params)) (*Slice[Demand], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых отгрузок и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, demandList Slice[Demand], params ...func(*Params)) (*BulkResult[Demand], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление отгрузок.
 	// Принимает контекст и множество отгрузок.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Demand) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Demand) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление отгрузки по ID.
 	// Принимает контекст и ID отгрузки.
@@ -1005,6 +1033,11 @@ type DemandService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[DemandPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*DemandPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -1022,8 +1055,8 @@ type DemandService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*DemandPosition) (*Slice[DemandPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*DemandPosition) (*BulkResult[DemandPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -1035,6 +1068,12 @@ type DemandService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*DemandPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[DemandPosition], key func(position *DemandPosition) string) (*PositionSyncResult[DemandPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -1068,8 +1107,8 @@ type DemandService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -1083,8 +1122,8 @@ type DemandService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -1173,8 +1212,8 @@ type DemandService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
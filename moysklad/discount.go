@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Discount Скидка.
@@ -751,6 +752,21 @@ type DiscountService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Discount], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех скидок.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Discount, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех скидок.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Discount]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех скидок асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Discount, error]
+
 	// UpdateRoundOffDiscount выполняет запрос на изменение округления копеек.
 	// Принимает контекст, ID округления копеек и скидку.
 	// Возвращает скидку.
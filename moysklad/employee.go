@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"net/http"
 	"time"
@@ -516,6 +517,21 @@ type EmployeeService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Employee], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех сотрудников.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Employee, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех сотрудников.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Employee]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех сотрудников асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Employee, error]
+
 	// Create выполняет запрос на создание сотрудника.
 	// Обязательные поля для заполнения:
 	//	- lastName (Фамилия)
@@ -526,13 +542,13 @@ type EmployeeService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение сотрудников.
 	// Изменяемые сотрудники должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список сотрудников и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых сотрудников.
-	CreateUpdateMany(ctx context.Context, employeeList Slice[Employee], params ...func(*Params)) (*Slice[Employee], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых сотрудников и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, employeeList Slice[Employee], params ...func(*Params)) (*BulkResult[Employee], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление сотрудников.
 	// Принимает контекст и множество сотрудников.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Employee) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Employee) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление сотрудника по ID.
 	// Принимает контекст и ID сотрудника.
@@ -567,8 +583,8 @@ type EmployeeService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -582,8 +598,8 @@ type EmployeeService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetByID выполняет запрос на получение отдельного сотрудника по ID.
 	// Принимает контекст, ID сотрудника и опционально объект параметров запроса Params.
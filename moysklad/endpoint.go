@@ -7,6 +7,7 @@ import (
 	"github.com/go-resty/resty/v2"
 
 	"io"
+	"iter"
 	"net/http"
 	"regexp"
 	"strings"
@@ -152,6 +153,43 @@ func (endpoint *endpointGetList[T]) GetListAll(ctx context.Context, params ...fu
 	return getAll[T](ctx, endpoint.client, endpoint.uri, params)
 }
 
+// GetListSeq возвращает итератор, который постранично запрашивает объекты по мере перебора.
+//
+// # Пример:
+//
+//	for product, err := range client.Entity().Product().GetListSeq(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(product.GetName())
+//	}
+func (endpoint *endpointGetList[T]) GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*T, error] {
+	return listSeq[T](ctx, endpoint.client, endpoint.uri, params)
+}
+
+// GetListAsync выполняет асинхронный запрос на получение списка объектов.
+//
+// Результат содержит все объекты без ограничения на количество.
+func (endpoint *endpointGetList[T]) GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[T]], *resty.Response, error) {
+	return NewRequestBuilder[List[T]](endpoint.client, endpoint.uri).SetParams(params).Async(ctx)
+}
+
+// GetListAllAsync возвращает итератор по всем объектам, полученным асинхронной задачей.
+//
+// Объекты читаются из результата задачи по мере перебора, не загружая его в память целиком.
+//
+// # Пример:
+//
+//	for product, err := range client.Entity().Product().GetListAllAsync(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(product.GetName())
+//	}
+func (endpoint *endpointGetList[T]) GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*T, error] {
+	return asyncListSeq[T](ctx, endpoint.client, endpoint.uri, params)
+}
+
 type endpointDeleteByID struct{ Endpoint }
 
 // DeleteByID выполняет запрос на удаление объекта по ID.
@@ -287,7 +325,7 @@ type endpointDeleteMany[T MetaOwner] struct{ Endpoint }
 // [Документация МойСклад]
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/index.html#mojsklad-json-api-obschie-swedeniq-sozdanie-i-obnowlenie-neskol-kih-ob-ektow
-func (endpoint *endpointDeleteMany[T]) DeleteMany(ctx context.Context, entities ...*T) (*DeleteManyResponse, *resty.Response, error) {
+func (endpoint *endpointDeleteMany[T]) DeleteMany(ctx context.Context, entities ...*T) (*BulkResult[DeleteManyRow], *resty.Response, error) {
 	path := fmt.Sprintf("%s/delete", endpoint.uri)
 	return deleteAll[T](ctx, endpoint.client, path, entities)
 }
@@ -299,7 +337,7 @@ type endpointCreateUpdateMany[T any] struct{ Endpoint }
 // [Документация МойСклад]
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/index.html#mojsklad-json-api-obschie-swedeniq-sozdanie-i-obnowlenie-neskol-kih-ob-ektow
-func (endpoint *endpointCreateUpdateMany[T]) CreateUpdateMany(ctx context.Context, entities Slice[T], params ...func(*Params)) (*Slice[T], *resty.Response, error) {
+func (endpoint *endpointCreateUpdateMany[T]) CreateUpdateMany(ctx context.Context, entities Slice[T], params ...func(*Params)) (*BulkResult[T], *resty.Response, error) {
 	return posAll[T](ctx, endpoint.client, endpoint.uri, entities, params)
 }
 
@@ -380,15 +418,11 @@ func (endpoint *endpointAttributes) CreateAttribute(ctx context.Context, attribu
 // [Документация МойСклад]
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/index.html#mojsklad-json-api-obschie-swedeniq-dopolnitel-nye-polq-suschnostej-sozdat-dopolnitel-nye-polq
-func (endpoint *endpointAttributes) CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error) {
+func (endpoint *endpointAttributes) CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error) {
 	path := fmt.Sprintf(EndpointAttributes, endpoint.uri)
 	// при передаче массива из 1-го доп поля сервис возвращает 1 доп поле, а не массив доп полей.
-	// если количество передаваемых доп полей равняется 1, то дополнительно оборачиваем в срез.
-	if len(attributes) == 1 {
-		attribute, resp, err := NewRequestBuilder[Attribute](endpoint.client, path).Post(ctx, attributes[0])
-		return (&Slice[Attribute]{}).Push(attribute), resp, err
-	}
-	return NewRequestBuilder[Slice[Attribute]](endpoint.client, path).Post(ctx, attributes)
+	// такой ответ сопоставляется с единственным элементом при разборе ответа.
+	return posAll[Attribute](ctx, endpoint.client, path, attributes, nil)
 }
 
 // UpdateAttribute выполняет запрос на изменение дополнительного поля.
@@ -416,9 +450,9 @@ func (endpoint *endpointAttributes) DeleteAttribute(ctx context.Context, id stri
 // [Документация МойСклад]
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/index.html#mojsklad-json-api-obschie-swedeniq-dopolnitel-nye-polq-suschnostej-udalit-dopolnitel-nye-polq
-func (endpoint *endpointAttributes) DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error) {
+func (endpoint *endpointAttributes) DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error) {
 	path := fmt.Sprintf(EndpointAttributesDelete, endpoint.uri)
-	return NewRequestBuilder[DeleteManyResponse](endpoint.client, path).Post(ctx, AsMetaWrapperSlice(attributes))
+	return deleteAll[Attribute](ctx, endpoint.client, path, attributes)
 }
 
 type endpointAudit struct{ Endpoint }
@@ -559,11 +593,18 @@ func (endpoint *endpointPositions[T]) GetPositionList(ctx context.Context, id st
 	return NewRequestBuilder[List[T]](endpoint.client, path).SetParams(params).Get(ctx)
 }
 
+// GetPositionListAll выполняет запрос на получение всех позиций документа в виде списка.
 func (endpoint *endpointPositions[T]) GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[T], *resty.Response, error) {
 	path := fmt.Sprintf(EndpointPositions, endpoint.uri, id)
 	return getAll[T](ctx, endpoint.client, path, params)
 }
 
+// GetPositionListSeq возвращает итератор, который постранично запрашивает позиции документа по мере перебора.
+func (endpoint *endpointPositions[T]) GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*T, error] {
+	path := fmt.Sprintf(EndpointPositions, endpoint.uri, id)
+	return listSeq[T](ctx, endpoint.client, path, params)
+}
+
 // GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 func (endpoint *endpointPositions[T]) GetPositionByID(ctx context.Context, id, positionID string, params ...func(*Params)) (*T, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointPositionsID, endpoint.uri, id, positionID)
@@ -583,7 +624,7 @@ func (endpoint *endpointPositions[T]) CreatePosition(ctx context.Context, id str
 }
 
 // CreatePositionMany выполняет запрос на массовое создание позиций документа.
-func (endpoint *endpointPositions[T]) CreatePositionMany(ctx context.Context, id string, positions ...*T) (*Slice[T], *resty.Response, error) {
+func (endpoint *endpointPositions[T]) CreatePositionMany(ctx context.Context, id string, positions ...*T) (*BulkResult[T], *resty.Response, error) {
 	path := fmt.Sprintf(EndpointPositions, endpoint.uri, id)
 	return posAll[T](ctx, endpoint.client, path, positions, nil)
 }
@@ -595,9 +636,28 @@ func (endpoint *endpointPositions[T]) DeletePosition(ctx context.Context, id, po
 }
 
 // DeletePositionMany выполняет запрос на удаление нескольких позиций документа.
+//
+// Позиции передаются метаданными, сформированными по ID позиций.
 func (endpoint *endpointPositions[T]) DeletePositionMany(ctx context.Context, id string, entities ...*T) (*DeleteManyResponse, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointPositionsDelete, endpoint.uri, id)
-	return NewRequestBuilder[DeleteManyResponse](endpoint.client, path).Post(ctx, entities)
+	return NewRequestBuilder[DeleteManyResponse](endpoint.client, path).Post(ctx, endpoint.positionMetaWrappers(id, entities))
+}
+
+// positionMetaWrappers возвращает метаданные позиций документа с ID id.
+//
+// Позиции документов не содержат метаданных, поэтому ссылка на позицию формируется по её ID.
+func (endpoint *endpointPositions[T]) positionMetaWrappers(id string, entities []*T) []MetaWrapper {
+	wrappers := make([]MetaWrapper, 0, len(entities))
+	for _, entity := range entities {
+		if entity == nil {
+			continue
+		}
+
+		href := strings.TrimSuffix(endpoint.client.BaseURL, "/") + fmt.Sprintf(EndpointPositionsID, endpoint.uri, id, positionID(entity))
+		meta := new(Meta).SetHref(href).SetType(MetaTypeFromEntity(entity)).SetMediaType(ApplicationJson)
+		wrappers = append(wrappers, meta.Wrap())
+	}
+	return wrappers
 }
 
 // GetPositionTrackingCodeList выполняет запрос на получение коды маркировки позиции документа.
@@ -776,9 +836,9 @@ func (endpoint *endpointStates) UpdateState(ctx context.Context, id string, stat
 // [Документация МойСклад]
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-statusy-dokumentow-massowoe-sozdanie-i-obnowlenie-statusow
-func (endpoint *endpointStates) CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error) {
+func (endpoint *endpointStates) CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error) {
 	path := fmt.Sprintf(EndpointStates, endpoint.uri)
-	return NewRequestBuilder[Slice[State]](endpoint.client, path).Post(ctx, AsMetaWrapperSlice(states))
+	return posAll[State](ctx, endpoint.client, path, states, nil)
 }
 
 // DeleteState выполняет запрос на удаление Статуса с указанным id.
@@ -857,7 +917,10 @@ type endpointTrash struct{ Endpoint }
 func (endpoint *endpointTrash) MoveToTrash(ctx context.Context, id string) (bool, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointTrash, endpoint.uri, id)
 	_, resp, err := NewRequestBuilder[any](endpoint.client, path).Post(ctx, nil)
-	return resp.StatusCode() == http.StatusOK, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusOK, resp, nil
 }
 
 type endpointEvaluate[T any] struct{ Endpoint }
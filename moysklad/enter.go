@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -16,7 +17,7 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-oprihodowanie
 type Enter struct {
 	Organization *Organization             `json:"organization,omitempty"` // Метаданные юрлица
-	Sum          *float64                  `json:"sum,omitempty"`          // Сумма Оприходования в копейках
+	Sum          *Money                    `json:"sum,omitempty"`          // Сумма Оприходования в копейках
 	Moment       *Timestamp                `json:"moment,omitempty"`       // Дата документа
 	Code         *string                   `json:"code,omitempty"`         // Код Оприходования
 	Created      *Timestamp                `json:"created,omitempty"`      // Дата создания
@@ -67,7 +68,7 @@ func (enter Enter) GetOrganization() Organization {
 
 // GetSum возвращает Сумму Оприходования в копейках.
 func (enter Enter) GetSum() float64 {
-	return Deref(enter.Sum)
+	return Deref(enter.Sum).Float()
 }
 
 // GetMoment возвращает Дату документа.
@@ -382,9 +383,9 @@ type EnterPosition struct {
 	Country    *NullValue[Country] `json:"country,omitempty"`    // Метаданные Страны
 	GTD        *GTD                `json:"gtd,omitempty"`        // ГТД
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Overhead   *float64            `json:"overhead,omitempty"`   // Накладные расходы. Если Позиции Оприходования не заданы, то накладные расходы нельзя задать
+	Overhead   *Money              `json:"overhead,omitempty"`   // Накладные расходы. Если Позиции Оприходования не заданы, то накладные расходы нельзя задать
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Reason     *string             `json:"reason,omitempty"`     // Причина оприходования данной позиции
 	Slot       *Slot               `json:"slot,omitempty"`       // Ячейка на складе
@@ -423,7 +424,7 @@ func (enterPosition EnterPosition) GetID() string {
 
 // GetOverhead возвращает Накладные расходы.
 func (enterPosition EnterPosition) GetOverhead() float64 {
-	return Deref(enterPosition.Overhead)
+	return Deref(enterPosition.Overhead).Float()
 }
 
 // GetPack возвращает Упаковку Товара.
@@ -433,7 +434,7 @@ func (enterPosition EnterPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (enterPosition EnterPosition) GetPrice() float64 {
-	return Deref(enterPosition.Price)
+	return Deref(enterPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в позиции.
@@ -498,7 +499,7 @@ func (enterPosition *EnterPosition) SetPack(pack *Pack) *EnterPosition {
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (enterPosition *EnterPosition) SetPrice(price float64) *EnterPosition {
-	enterPosition.Price = &price
+	enterPosition.Price = NewMoney(MoneyFromFloat(price))
 	return enterPosition
 }
 
@@ -558,6 +559,21 @@ type EnterService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Enter], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех оприходований.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Enter, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех оприходований.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Enter]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех оприходований асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Enter, error]
+
 	// Create выполняет запрос на создание оприходования.
 	// Обязательные поля для заполнения:
 	//	- organization (Метаданные юрлица)
@@ -569,13 +585,13 @@ type EnterService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение оприходований.
 	// Изменяемые оприходования должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список оприходований и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых оприходований.
-	CreateUpdateMany(ctx context.Context, enterList Slice[Enter], params ...func(*Params)) (*Slice[Enter], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых оприходований и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, enterList Slice[Enter], params ...func(*Params)) (*BulkResult[Enter], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление оприходований.
 	// Принимает контекст и множество оприходований.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Enter) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Enter) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление оприходования по ID.
 	// Принимает контекст и ID оприходования.
@@ -622,6 +638,11 @@ type EnterService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[EnterPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*EnterPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -639,8 +660,8 @@ type EnterService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*EnterPosition) (*Slice[EnterPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*EnterPosition) (*BulkResult[EnterPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -652,6 +673,12 @@ type EnterService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*EnterPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[EnterPosition], key func(position *EnterPosition) string) (*PositionSyncResult[EnterPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -685,8 +712,8 @@ type EnterService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -700,8 +727,8 @@ type EnterService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -765,8 +792,8 @@ type EnterService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -1,6 +1,60 @@
 package moysklad
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+)
+
+// Ошибки, по которым классифицируются ответы API МойСклад.
+//
+// Проверяются с помощью [errors.Is]:
+//
+//	if errors.Is(err, moysklad.ErrNotFound) {
+//		// ...
+//	}
+var (
+	ErrUnauthorized = errors.New("moysklad: authentication failed")    // Ошибка аутентификации (401)
+	ErrForbidden    = errors.New("moysklad: insufficient permissions") // Недостаточно прав (403)
+	ErrNotFound     = errors.New("moysklad: entity not found")         // Сущность не найдена (404)
+	ErrConflict     = errors.New("moysklad: conflict")                 // Конфликт, в том числе наличие зависимостей у удаляемой сущности (409)
+	ErrValidation   = errors.New("moysklad: validation failed")        // Ошибка валидации запроса (400, 412, 422)
+	ErrRateLimited  = errors.New("moysklad: rate limit exceeded")      // Превышено ограничение на количество запросов (429)
+	ErrServer       = errors.New("moysklad: server error")             // Ошибка на стороне сервера (5xx)
+)
+
+const (
+	ApiErrorCodeRateLimit         = 1049 // Превышено ограничение на количество запросов
+	ApiErrorCodeParallelRateLimit = 1073 // Превышено ограничение на количество параллельных запросов
+)
+
+// classifyError возвращает одну из ошибок Err* по HTTP статусу и коду ошибки API.
+//
+// Возвращает nil, если ошибку не удалось классифицировать.
+func classifyError(statusCode, code int, hasDependencies bool) error {
+	switch {
+	case hasDependencies:
+		return ErrConflict
+	case statusCode == http.StatusTooManyRequests, code == ApiErrorCodeRateLimit, code == ApiErrorCodeParallelRateLimit:
+		return ErrRateLimited
+	case statusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case statusCode == http.StatusForbidden:
+		return ErrForbidden
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusPreconditionFailed, statusCode == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case statusCode >= http.StatusInternalServerError:
+		return ErrServer
+	default:
+		return nil
+	}
+}
 
 // ApiError Структура ошибки API МойСклад.
 //
@@ -17,6 +71,7 @@ type ApiError struct {
 	Code         int         `json:"code,omitempty"`          // Код ошибки (Если поле ничего не содержит, смотрите HTTP status cod
 	Line         int         `json:"line,omitempty"`          // Строка JSON, на которой произошла ошибка
 	Column       int         `json:"column,omitempty"`        // Координата элемента в строке line, на котором произошла ошибка
+	StatusCode   int         `json:"-"`                       // HTTP статус ответа
 }
 
 // Error выводит ошибку в формате JSON
@@ -25,12 +80,109 @@ func (apiError ApiError) Error() string {
 	return string(b)
 }
 
+// Unwrap возвращает одну из ошибок Err*, соответствующую HTTP статусу и коду ошибки.
+func (apiError ApiError) Unwrap() error {
+	return classifyError(apiError.StatusCode, apiError.Code, len(apiError.Dependencies) > 0)
+}
+
+// As позволяет получить ошибку с помощью [errors.As] как в форме *ApiError, так и в форме значения ApiError.
+func (apiError *ApiError) As(target any) bool {
+	if value, ok := target.(*ApiError); ok {
+		*value = *apiError
+		return true
+	}
+	return false
+}
+
+// typed оборачивает ошибку в [DependencyError] или [ValidationError], если это возможно.
+func (apiError *ApiError) typed() error {
+	switch {
+	case len(apiError.Dependencies) > 0:
+		return &DependencyError{*apiError}
+	case apiError.Parameter != "" || apiError.Line > 0:
+		return &ValidationError{*apiError}
+	default:
+		return apiError
+	}
+}
+
+// DependencyError ошибка удаления сущности или документа, от которых зависят другие сущности или документы.
+//
+// Список зависимостей содержится в поле Dependencies.
+type DependencyError struct {
+	ApiError
+}
+
+// Unwrap возвращает исходную ошибку [ApiError].
+func (dependencyError *DependencyError) Unwrap() error {
+	return &dependencyError.ApiError
+}
+
+// ValidationError ошибка в переданном параметре или теле запроса.
+//
+// Параметр, на котором произошла ошибка, содержится в поле Parameter.
+type ValidationError struct {
+	ApiError
+}
+
+// Unwrap возвращает исходную ошибку [ApiError].
+func (validationError *ValidationError) Unwrap() error {
+	return &validationError.ApiError
+}
+
 // ApiErrors Структура ошибок API МойСклад.
 type ApiErrors struct {
-	ApiErrors Slice[ApiError] `json:"errors"` // Список ошибок
+	ApiErrors  Slice[ApiError] `json:"errors"` // Список ошибок
+	StatusCode int             `json:"-"`      // HTTP статус ответа
+	Method     string          `json:"-"`      // Метод запроса
+	URL        string          `json:"-"`      // URL запроса
 }
 
 func (apiErrors ApiErrors) Error() string {
+	if len(apiErrors.ApiErrors) == 0 && apiErrors.StatusCode != 0 {
+		return fmt.Sprintf("%s %s: %d %s", apiErrors.Method, apiErrors.URL, apiErrors.StatusCode, http.StatusText(apiErrors.StatusCode))
+	}
 	b, _ := json.Marshal(apiErrors)
 	return string(b)
 }
+
+// Unwrap возвращает ошибку, соответствующую HTTP статусу ответа, и каждую ошибку из списка.
+//
+// Позволяет использовать [errors.Is] для проверки ошибок Err*
+// и [errors.As] для получения [DependencyError], [ValidationError] или [ApiError].
+// Ошибки из списка возвращаются как *ApiError, которые также можно получить в форме значения:
+//
+//	var apiError *moysklad.ApiError // или var apiError moysklad.ApiError
+//	if errors.As(err, &apiError) {
+//		fmt.Println(apiError.Code)
+//	}
+func (apiErrors ApiErrors) Unwrap() []error {
+	errs := make([]error, 0, len(apiErrors.ApiErrors)+1)
+	if err := classifyError(apiErrors.StatusCode, 0, false); err != nil {
+		errs = append(errs, err)
+	}
+	for _, apiError := range apiErrors.ApiErrors {
+		if apiError != nil {
+			errs = append(errs, apiError.typed())
+		}
+	}
+	return errs
+}
+
+// newApiErrors возвращает [ApiErrors] с HTTP статусом и URL запроса из ответа.
+func newApiErrors(resp *resty.Response, errs Slice[ApiError]) ApiErrors {
+	apiErrors := ApiErrors{ApiErrors: errs, StatusCode: resp.StatusCode()}
+
+	if resp.Request != nil {
+		apiErrors.Method = resp.Request.Method
+		apiErrors.URL = resp.Request.URL
+	}
+
+	for _, apiError := range apiErrors.ApiErrors {
+		if apiError != nil && apiError.StatusCode == 0 {
+			apiError.StatusCode = apiErrors.StatusCode
+		}
+	}
+
+	return apiErrors
+}
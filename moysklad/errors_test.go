@@ -0,0 +1,90 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+)
+
+func TestApiErrorsAs(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		is         error
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name:       "api error",
+			statusCode: http.StatusNotFound,
+			body:       `{"errors":[{"error":"Объект не найден","code":1021}]}`,
+			is:         moysklad.ErrNotFound,
+		},
+		{
+			name:       "dependency error",
+			statusCode: http.StatusConflict,
+			body:       `{"errors":[{"error":"Нельзя удалить","code":1000,"dependencies":[{"href":"https://api.moysklad.ru/api/remap/1.2/entity/demand/1","type":"demand"}]}]}`,
+			is:         moysklad.ErrConflict,
+			check: func(t *testing.T, err error) {
+				var dependencyError *moysklad.DependencyError
+				if !errors.As(err, &dependencyError) || len(dependencyError.Dependencies) != 1 {
+					t.Fatalf("got error %v, want dependency error", err)
+				}
+			},
+		},
+		{
+			name:       "validation error",
+			statusCode: http.StatusBadRequest,
+			body:       `{"errors":[{"error":"Неверное значение","code":1000,"parameter":"name"}]}`,
+			is:         moysklad.ErrValidation,
+			check: func(t *testing.T, err error) {
+				var validationError *moysklad.ValidationError
+				if !errors.As(err, &validationError) || validationError.Parameter != "name" {
+					t.Fatalf("got error %v, want validation error", err)
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := moysklad.New(moysklad.Config{BaseURL: server.URL, Token: "test"})
+
+			_, _, err := client.Entity().Product().GetByID(context.Background(), "7944ef04-f831-11e5-7a69-971500188b19")
+			if !errors.Is(err, test.is) {
+				t.Fatalf("got error %v, want %v", err, test.is)
+			}
+
+			var apiErrors moysklad.ApiErrors
+			if !errors.As(err, &apiErrors) || apiErrors.StatusCode != test.statusCode {
+				t.Fatalf("got error %v, want api errors with status %d", err, test.statusCode)
+			}
+
+			// ошибка из списка доступна в форме указателя и в форме значения
+			var apiError *moysklad.ApiError
+			if !errors.As(err, &apiError) || apiError.Code != 1000 && apiError.Code != 1021 || apiError.StatusCode != test.statusCode {
+				t.Fatalf("got error %v, want *ApiError", err)
+			}
+
+			var apiErrorValue moysklad.ApiError
+			if !errors.As(err, &apiErrorValue) || apiErrorValue.Code != apiError.Code || apiErrorValue.Header != apiError.Header {
+				t.Fatalf("got error %v, want ApiError", err)
+			}
+
+			if test.check != nil {
+				test.check(t, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -142,6 +143,21 @@ type ExpenseItemService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[ExpenseItem], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех статей расходов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ExpenseItem, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех статей расходов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[ExpenseItem]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех статей расходов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*ExpenseItem, error]
+
 	// Create выполняет запрос на создание статьи расходов.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование Статьи расходов)
@@ -152,13 +168,13 @@ type ExpenseItemService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или статей расходов.
 	// Изменяемые статьи расходов должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список статей расходов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых статей расходов.
-	CreateUpdateMany(ctx context.Context, expenseItemList Slice[ExpenseItem], params ...func(*Params)) (*Slice[ExpenseItem], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статей расходов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, expenseItemList Slice[ExpenseItem], params ...func(*Params)) (*BulkResult[ExpenseItem], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление статей расходов.
 	// Принимает контекст и множество статей расходов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*ExpenseItem) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*ExpenseItem) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление статьи расходов по ID.
 	// Принимает контекст и ID статьи расходов.
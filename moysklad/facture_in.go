@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -38,7 +39,7 @@ type FactureIn struct {
 	Rate           *NullValue[Rate]     `json:"rate,omitempty"`           // Валюта
 	Shared         *bool                `json:"shared,omitempty"`         // Общий доступ
 	State          *NullValue[State]    `json:"state,omitempty"`          // Метаданные статуса полученного счета-фактуры
-	Sum            *float64             `json:"sum,omitempty"`            // Сумма полученного счета-фактуры в установленной валюте
+	Sum            *Money               `json:"sum,omitempty"`            // Сумма полученного счета-фактуры в установленной валюте
 	SyncID         *string              `json:"syncId,omitempty"`         // ID синхронизации
 	Updated        *Timestamp           `json:"updated,omitempty"`        // Момент последнего обновления полученного счета-фактуры
 	Supplies       Slice[Supply]        `json:"supplies,omitempty"`       // Массив ссылок на связанные приемки
@@ -184,7 +185,7 @@ func (factureIn FactureIn) GetState() State {
 
 // GetSum возвращает Сумму полученного счета-фактуры в установленной валюте.
 func (factureIn FactureIn) GetSum() float64 {
-	return Deref(factureIn.Sum)
+	return Deref(factureIn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -410,6 +411,21 @@ type FactureInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[FactureIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех полученных счетов-фактур.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*FactureIn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех полученных счетов-фактур.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[FactureIn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех полученных счетов-фактур асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*FactureIn, error]
+
 	// Create выполняет запрос на создание полученного счета-фактуры.
 	// Обязательные поля для заполнения:
 	//	- incomingNumber (Входящий номер)
@@ -421,13 +437,13 @@ type FactureInService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение полученных счетов-фактур.
 	// Изменяемые полученные счета-фактуры должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список полученных счетов-фактур и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых полученных счетов-фактур.
-	CreateUpdateMany(ctx context.Context, factureInList Slice[FactureIn], params ...func(*Params)) (*Slice[FactureIn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых полученных счетов-фактур и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, factureInList Slice[FactureIn], params ...func(*Params)) (*BulkResult[FactureIn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление полученных счетов-фактур.
 	// Принимает контекст и множество полученных счетов-фактур.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*FactureIn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*FactureIn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление полученного счета-фактуры по ID.
 	// Принимает контекст и ID полученного счета-фактуры.
@@ -472,8 +488,8 @@ type FactureInService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -487,8 +503,8 @@ type FactureInService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// Template выполняет запрос на получение предзаполненного полученного счета-фактуры со стандартными полями.
 	// без связи с какими-либо другими документами.
@@ -556,8 +572,8 @@ type FactureInService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -39,7 +40,7 @@ type FactureOut struct {
 	Shared          *bool                 `json:"shared,omitempty"`          // Общий доступ
 	State           *NullValue[State]     `json:"state,omitempty"`           // Метаданные статуса выданного Счета-фактуры
 	StateContractID *string               `json:"stateContractId,omitempty"` // Идентификатор государственного контракта, договора (соглашения)
-	Sum             *float64              `json:"sum,omitempty"`             // Сумма выданного Счета-фактуры в копейках
+	Sum             *Money                `json:"sum,omitempty"`             // Сумма выданного Счета-фактуры в копейках
 	SyncID          *string               `json:"syncId,omitempty"`          // ID синхронизации
 	Updated         *Timestamp            `json:"updated,omitempty"`         // Момент последнего обновления выданного Счета-фактуры
 	Demands         Slice[Demand]         `json:"demands,omitempty"`         // Массив ссылок на связанные отгрузки
@@ -192,7 +193,7 @@ func (factureOut FactureOut) GetStateContractID() string {
 
 // GetSum возвращает Сумму выданного Счета-фактуры в копейках.
 func (factureOut FactureOut) GetSum() float64 {
-	return Deref(factureOut.Sum)
+	return Deref(factureOut.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -452,6 +453,21 @@ type FactureOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[FactureOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех выданных счетов-фактур.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*FactureOut, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех выданных счетов-фактур.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[FactureOut]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех выданных счетов-фактур асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*FactureOut, error]
+
 	// Create выполняет запрос на создание выданного счета-фактуры.
 	// Обязательные поля для заполнения:
 	//	- paymentNumber (Название платежного документа)
@@ -463,13 +479,13 @@ type FactureOutService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение выданных счетов-фактур.
 	// Изменяемые выданные счета-фактуры должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список выданных счетов-фактур и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых выданных счетов-фактур.
-	CreateUpdateMany(ctx context.Context, factureOutList Slice[FactureOut], params ...func(*Params)) (*Slice[FactureOut], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых выданных счетов-фактур и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, factureOutList Slice[FactureOut], params ...func(*Params)) (*BulkResult[FactureOut], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление выданных счетов-фактур.
 	// Принимает контекст и множество выданных счетов-фактур.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*FactureOut) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*FactureOut) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление выданного счета-фактуры по ID.
 	// Принимает контекст и ID выданного счета-фактуры.
@@ -514,8 +530,8 @@ type FactureOutService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -529,8 +545,8 @@ type FactureOutService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -598,8 +614,8 @@ type FactureOutService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -0,0 +1,398 @@
+// Package filter содержит построитель выражений фильтрации для параметра filter.
+//
+// Выражение начинается с условия на поле ([Field]), доп. поле ([Attr]) или ссылку на объект ([Meta])
+// и передаётся в запрос с помощью [moysklad.WithFilterExpr]. Значения экранируются,
+// моменты времени форматируются в часовом поясе учётной записи клиента, а сочетание оператора и типа значения проверяется.
+//
+// # Пример:
+//
+//	orders, _, err := client.Entity().CustomerOrder().GetListAll(ctx, moysklad.WithFilterExpr(
+//		filter.Meta("agent").In(counterparty1, counterparty2),
+//		filter.Field("moment").Between(from, to),
+//		filter.Attr(attribute).Eq("VIP"),
+//		filter.Field("description").NotEmpty(),
+//	))
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"github.com/arcsub/go-moysklad/moysklad"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalid возвращается, если оператор не поддерживается полем или типом значения.
+var ErrInvalid = errors.New("filter: invalid expression")
+
+// kind тип значения поля.
+type kind int
+
+const (
+	kindAny kind = iota
+	kindString
+	kindNumber
+	kindTime
+	kindBool
+	kindMeta
+	kindFile
+)
+
+// String реализует интерфейс [fmt.Stringer].
+func (kind kind) String() string {
+	switch kind {
+	case kindString:
+		return "string"
+	case kindNumber:
+		return "number"
+	case kindTime:
+		return "time"
+	case kindBool:
+		return "bool"
+	case kindMeta:
+		return "meta"
+	case kindFile:
+		return "file"
+	default:
+		return "any"
+	}
+}
+
+// operators операторы, допустимые для типа значения.
+var operators = map[kind][]moysklad.FilterType{
+	kindString: {moysklad.FilterEquals, moysklad.FilterNotEquals, moysklad.FilterEquivalence, moysklad.FilterNotEquivalence, moysklad.FilterEquivalenceLeft, moysklad.FilterEquivalenceRight},
+	kindNumber: {moysklad.FilterEquals, moysklad.FilterNotEquals, moysklad.FilterGreater, moysklad.FilterGreaterOrEquals, moysklad.FilterLesser, moysklad.FilterLesserOrEquals},
+	kindTime:   {moysklad.FilterEquals, moysklad.FilterNotEquals, moysklad.FilterGreater, moysklad.FilterGreaterOrEquals, moysklad.FilterLesser, moysklad.FilterLesserOrEquals},
+	kindBool:   {moysklad.FilterEquals, moysklad.FilterNotEquals},
+	kindMeta:   {moysklad.FilterEquals, moysklad.FilterNotEquals},
+}
+
+// condition условие фильтрации.
+type condition struct {
+	key    string
+	op     moysklad.FilterType
+	value  string    // Значение в формате API
+	time   time.Time // Момент времени, форматируемый в часовом поясе запроса
+	isTime bool
+}
+
+// format возвращает условие в формате "ключ оператор значение" с моментом времени в часовом поясе loc.
+func (condition condition) format(loc *time.Location) string {
+	value := condition.value
+	if condition.isTime {
+		value = condition.time.In(loc).Format(moysklad.TimestampFormat)
+	}
+	return condition.key + string(condition.op) + moysklad.EscapeFilterValue(value)
+}
+
+// Expr выражение фильтрации.
+//
+// Реализует интерфейс [moysklad.FilterExpr].
+type Expr struct {
+	conditions []condition
+	err        error
+}
+
+// FilterConditions реализует интерфейс [moysklad.FilterExpr].
+func (expr Expr) FilterConditions(loc *time.Location) ([]string, error) {
+	if expr.err != nil {
+		return nil, expr.err
+	}
+
+	conditions := make([]string, 0, len(expr.conditions))
+	for _, condition := range expr.conditions {
+		conditions = append(conditions, condition.format(loc))
+	}
+	return conditions, nil
+}
+
+// Err возвращает ошибку построения выражения.
+func (expr Expr) Err() error {
+	return expr.err
+}
+
+// String реализует интерфейс [fmt.Stringer].
+//
+// Моменты времени форматируются в часовом поясе по умолчанию ([moysklad.Location]).
+func (expr Expr) String() string {
+	conditions, _ := expr.FilterConditions(moysklad.Location())
+	return strings.Join(conditions, ";")
+}
+
+// Term поле, доп. поле или ссылка на объект, по которым строится выражение фильтрации.
+type Term struct {
+	err  error
+	key  string
+	kind kind
+}
+
+// Field возвращает условие на поле сущности name.
+//
+// Допустимые операторы определяются по типу переданного значения.
+func Field(name string) Term {
+	return Term{key: name, kind: kindAny}
+}
+
+// Meta возвращает условие на поле name, содержащее ссылку на объект.
+//
+// Принимает значения, реализующие интерфейс [moysklad.MetaOwner], объекты [moysklad.Meta] или ссылки в виде строки.
+func Meta(name string) Term {
+	return Term{key: name, kind: kindMeta}
+}
+
+// Attr возвращает условие на доп. поле attribute.
+//
+// Ключом фильтрации является ссылка на доп. поле, поэтому доп. поле должно содержать метаданные.
+// Допустимые операторы определяются по типу доп. поля.
+func Attr(attribute *moysklad.Attribute) Term {
+	if attribute == nil || attribute.GetMeta().GetHref() == "" {
+		return Term{err: fmt.Errorf("%w: attribute without meta", ErrInvalid)}
+	}
+
+	term := Term{key: attribute.GetMeta().GetHref()}
+
+	switch attribute.GetType() {
+	case moysklad.AttributeTypeString, moysklad.AttributeTypeText, moysklad.AttributeTypeLink:
+		term.kind = kindString
+	case moysklad.AttributeTypeLong, moysklad.AttributeTypeDouble:
+		term.kind = kindNumber
+	case moysklad.AttributeTypeTime:
+		term.kind = kindTime
+	case moysklad.AttributeTypeBoolean:
+		term.kind = kindBool
+	case moysklad.AttributeTypeFile:
+		term.kind = kindFile
+	default:
+		// доп. поля типа справочник
+		term.kind = kindMeta
+	}
+
+	return term
+}
+
+// Eq равно ("=").
+func (term Term) Eq(value any) Expr {
+	return term.expr(moysklad.FilterEquals, value)
+}
+
+// Ne не равно ("!=").
+func (term Term) Ne(value any) Expr {
+	return term.expr(moysklad.FilterNotEquals, value)
+}
+
+// Gt больше (">").
+func (term Term) Gt(value any) Expr {
+	return term.expr(moysklad.FilterGreater, value)
+}
+
+// Gte больше или равно (">=").
+func (term Term) Gte(value any) Expr {
+	return term.expr(moysklad.FilterGreaterOrEquals, value)
+}
+
+// Lt меньше ("<").
+func (term Term) Lt(value any) Expr {
+	return term.expr(moysklad.FilterLesser, value)
+}
+
+// Lte меньше или равно ("<=").
+func (term Term) Lte(value any) Expr {
+	return term.expr(moysklad.FilterLesserOrEquals, value)
+}
+
+// Between значение в диапазоне от from до to включительно (">=" и "<=").
+func (term Term) Between(from, to any) Expr {
+	return join(term.expr(moysklad.FilterGreaterOrEquals, from), term.expr(moysklad.FilterLesserOrEquals, to))
+}
+
+// In значение равно одному из values ("=" для каждого значения).
+//
+// Условия "=" с одинаковым ключом объединяются API по ИЛИ.
+func (term Term) In(values ...any) Expr {
+	if len(values) == 0 {
+		return Expr{err: fmt.Errorf("%w: %s: empty value list", ErrInvalid, term.key)}
+	}
+	return term.exprs(moysklad.FilterEquals, values)
+}
+
+// NotIn значение не равно ни одному из values ("!=" для каждого значения).
+func (term Term) NotIn(values ...any) Expr {
+	if len(values) == 0 {
+		return Expr{err: fmt.Errorf("%w: %s: empty value list", ErrInvalid, term.key)}
+	}
+	return term.exprs(moysklad.FilterNotEquals, values)
+}
+
+// Like частичное совпадение ("~").
+func (term Term) Like(value string) Expr {
+	return term.expr(moysklad.FilterEquivalence, value)
+}
+
+// NotLike частичное совпадение не выводится ("!~").
+func (term Term) NotLike(value string) Expr {
+	return term.expr(moysklad.FilterNotEquivalence, value)
+}
+
+// StartsWith полное совпадение в начале значения ("~=").
+func (term Term) StartsWith(value string) Expr {
+	return term.expr(moysklad.FilterEquivalenceLeft, value)
+}
+
+// EndsWith полное совпадение в конце значения ("=~").
+func (term Term) EndsWith(value string) Expr {
+	return term.expr(moysklad.FilterEquivalenceRight, value)
+}
+
+// IsEmpty значение не заполнено ("=" без значения).
+func (term Term) IsEmpty() Expr {
+	return term.empty(moysklad.FilterEquals)
+}
+
+// NotEmpty значение заполнено ("!=" без значения).
+func (term Term) NotEmpty() Expr {
+	return term.empty(moysklad.FilterNotEquals)
+}
+
+// empty возвращает условие на заполненность значения.
+func (term Term) empty(op moysklad.FilterType) Expr {
+	if term.err != nil {
+		return Expr{err: term.err}
+	}
+	if term.kind == kindBool {
+		return Expr{err: fmt.Errorf("%w: %s: %s does not support empty check", ErrInvalid, term.key, term.kind)}
+	}
+	return Expr{conditions: []condition{{key: term.key, op: op}}}
+}
+
+// exprs возвращает условия с оператором op для каждого значения.
+func (term Term) exprs(op moysklad.FilterType, values []any) Expr {
+	exprs := make([]Expr, 0, len(values))
+	for _, value := range values {
+		exprs = append(exprs, term.expr(op, value))
+	}
+	return join(exprs...)
+}
+
+// expr возвращает условие с оператором op и значением value.
+func (term Term) expr(op moysklad.FilterType, value any) Expr {
+	if term.err != nil {
+		return Expr{err: term.err}
+	}
+	if term.key == "" {
+		return Expr{err: fmt.Errorf("%w: empty field name", ErrInvalid)}
+	}
+
+	formatted, valueKind, err := format(value)
+	if err != nil {
+		return Expr{err: fmt.Errorf("%w: %s: %w", ErrInvalid, term.key, err)}
+	}
+
+	switch {
+	case term.kind == kindMeta && valueKind == kindString:
+		// ссылка на объект в виде строки
+		valueKind = kindMeta
+	case term.kind != kindAny && term.kind != valueKind:
+		return Expr{err: fmt.Errorf("%w: %s: expected %s value, got %T", ErrInvalid, term.key, term.kind, value)}
+	}
+
+	if !supports(valueKind, op) {
+		return Expr{err: fmt.Errorf("%w: %s: operator %q is not supported for %s value", ErrInvalid, term.key, op, valueKind)}
+	}
+
+	cond := condition{key: term.key, op: op, value: formatted}
+	cond.time, cond.isTime = timeOf(value)
+
+	return Expr{conditions: []condition{cond}}
+}
+
+// supports возвращает true, если оператор op допустим для типа значения.
+func supports(kind kind, op moysklad.FilterType) bool {
+	for _, supported := range operators[kind] {
+		if supported == op {
+			return true
+		}
+	}
+	return false
+}
+
+// format возвращает значение в формате API и его тип.
+//
+// Для моментов времени возвращается пустое значение: они форматируются при построении условий (см. [condition.format]).
+func format(value any) (string, kind, error) {
+	switch v := value.(type) {
+	case string:
+		return v, kindString, nil
+	case bool:
+		return strconv.FormatBool(v), kindBool, nil
+	case int:
+		return strconv.Itoa(v), kindNumber, nil
+	case int8, int16, int32, int64:
+		return fmt.Sprint(v), kindNumber, nil
+	case uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v), kindNumber, nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), kindNumber, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), kindNumber, nil
+	case time.Time, moysklad.Timestamp:
+		return "", kindTime, nil
+	case *moysklad.Timestamp:
+		if v == nil {
+			return "", kindAny, errors.New("nil value")
+		}
+		return "", kindTime, nil
+	case moysklad.Meta:
+		return metaHref(v)
+	case *moysklad.Meta:
+		if v == nil {
+			return "", kindAny, errors.New("nil value")
+		}
+		return metaHref(*v)
+	case moysklad.MetaOwner:
+		return metaHref(v.GetMeta())
+	case nil:
+		return "", kindAny, errors.New("nil value")
+	default:
+		return "", kindAny, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// timeOf возвращает момент времени значения value и true, если значение является моментом времени.
+func timeOf(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case moysklad.Timestamp:
+		return v.Time(), true
+	case *moysklad.Timestamp:
+		if v != nil {
+			return v.Time(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// metaHref возвращает ссылку на объект из метаданных.
+func metaHref(meta moysklad.Meta) (string, kind, error) {
+	if meta.GetHref() == "" {
+		return "", kindMeta, errors.New("meta without href")
+	}
+	return meta.GetHref(), kindMeta, nil
+}
+
+// join объединяет условия выражений. Возвращает все ошибки выражений.
+func join(exprs ...Expr) Expr {
+	var result Expr
+	var errs []error
+	for _, expr := range exprs {
+		result.conditions = append(result.conditions, expr.conditions...)
+		if expr.err != nil {
+			errs = append(errs, expr.err)
+		}
+	}
+	if result.err = errors.Join(errs...); result.err != nil {
+		result.conditions = nil
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package filter_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/filter"
+)
+
+const (
+	attributeHref    = "https://api.moysklad.ru/api/remap/1.2/entity/customerorder/metadata/attributes/1"
+	counterpartyHref = "https://api.moysklad.ru/api/remap/1.2/entity/counterparty/2"
+)
+
+func attribute(attributeType moysklad.AttributeType) *moysklad.Attribute {
+	return new(moysklad.Attribute).SetMeta(new(moysklad.Meta).SetHref(attributeHref)).SetType(attributeType)
+}
+
+func TestExpr(t *testing.T) {
+	moscow := time.FixedZone("UTC+3", 3*60*60)
+	moment := time.Date(2024, 3, 1, 7, 0, 0, 0, time.UTC)
+	counterparty := new(moysklad.Counterparty).SetMeta(new(moysklad.Meta).SetHref(counterpartyHref))
+
+	tests := []struct {
+		name string
+		expr filter.Expr
+		want []string
+	}{
+		{"escape", filter.Field("name").Eq("a;b;c"), []string{`name=a\;b\;c`}},
+		{"escape like", filter.Field("description").Like("x;y"), []string{`description~x\;y`}},
+		{"escape attribute", filter.Attr(attribute(moysklad.AttributeTypeString)).StartsWith("a;"), []string{attributeHref + `~=a\;`}},
+		{"number", filter.Field("sum").Gte(100), []string{"sum>=100"}},
+		{"float", filter.Field("sum").Lt(1.5), []string{"sum<1.5"}},
+		{"bool", filter.Attr(attribute(moysklad.AttributeTypeBoolean)).Eq(true), []string{attributeHref + "=true"}},
+		{"time", filter.Field("moment").Gt(moment), []string{"moment>2024-03-01 10:00:00.000"}},
+		{"timestamp", filter.Field("moment").Lte(moysklad.NewTimestamp(moment)), []string{"moment<=2024-03-01 10:00:00.000"}},
+		{"time attribute", filter.Attr(attribute(moysklad.AttributeTypeTime)).Eq(*moysklad.NewTimestamp(moment)), []string{attributeHref + "=2024-03-01 10:00:00.000"}},
+		{"between", filter.Field("moment").Between(moment, moment.Add(time.Hour)), []string{"moment>=2024-03-01 10:00:00.000", "moment<=2024-03-01 11:00:00.000"}},
+		{"meta", filter.Meta("agent").Eq(counterparty), []string{"agent=" + counterpartyHref}},
+		{"meta href", filter.Meta("agent").Ne(counterpartyHref), []string{"agent!=" + counterpartyHref}},
+		{"in", filter.Meta("agent").In(counterparty, counterparty.GetMeta()), []string{"agent=" + counterpartyHref, "agent=" + counterpartyHref}},
+		{"not in", filter.Field("name").NotIn("a", "b"), []string{"name!=a", "name!=b"}},
+		{"empty", filter.Field("description").IsEmpty(), []string{"description="}},
+		{"not empty", filter.Attr(attribute(moysklad.AttributeTypeDictionaryCounterParty)).NotEmpty(), []string{attributeHref + "!="}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.expr.FilterConditions(moscow)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Fatalf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExprInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		expr filter.Expr
+	}{
+		{"bool attribute operator", filter.Attr(attribute(moysklad.AttributeTypeBoolean)).Gt(true)},
+		{"bool attribute empty", filter.Attr(attribute(moysklad.AttributeTypeBoolean)).IsEmpty()},
+		{"string operator", filter.Field("name").Gt("str")},
+		{"bool operator", filter.Field("applicable").Gte(true)},
+		{"meta operator", filter.Meta("agent").Gt(counterpartyHref)},
+		{"attribute kind", filter.Attr(attribute(moysklad.AttributeTypeLong)).Eq("str")},
+		{"meta kind", filter.Meta("agent").Eq(1)},
+		{"nil attribute", filter.Attr(nil).Eq(1)},
+		{"attribute without meta", filter.Attr(new(moysklad.Attribute).SetType(moysklad.AttributeTypeLong)).Eq(1)},
+		{"empty field name", filter.Field("").Eq(1)},
+		{"nil value", filter.Field("name").Eq(nil)},
+		{"nil timestamp", filter.Field("moment").Eq((*moysklad.Timestamp)(nil))},
+		{"unsupported value", filter.Field("name").Eq(struct{}{})},
+		{"meta without href", filter.Meta("agent").Eq(&moysklad.Meta{})},
+		{"in without values", filter.Meta("agent").In()},
+		{"not in without values", filter.Field("name").NotIn()},
+		{"between", filter.Field("moment").Between(time.Now(), "str")},
+		{"in with invalid value", filter.Field("name").In("a", nil)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !errors.Is(test.expr.Err(), filter.ErrInvalid) {
+				t.Fatalf("got error %v, want ErrInvalid", test.expr.Err())
+			}
+			conditions, err := test.expr.FilterConditions(time.UTC)
+			if err == nil || conditions != nil {
+				t.Fatalf("got conditions %q, %v, want error", conditions, err)
+			}
+		})
+	}
+}
+
+func TestWithFilterExpr(t *testing.T) {
+	valid := filter.Field("name").Eq("a;b")
+	invalid := []filter.Expr{filter.Field("name").Gt("str"), filter.Field("sum").In()}
+
+	params := moysklad.ApplyParams([]func(*moysklad.Params){
+		moysklad.WithFilterExpr(valid, invalid[0]),
+		moysklad.WithFilterExpr(invalid[1]),
+	})
+
+	// ошибки всех выражений возвращаются вместе
+	for _, expr := range invalid {
+		if !errors.Is(params.Err(), expr.Err()) {
+			t.Fatalf("got error %v, want %v", params.Err(), expr.Err())
+		}
+	}
+	if !slices.Equal(params.Filter, []string{`name=a\;b`}) {
+		t.Fatalf("got filter %q, want only valid conditions", params.Filter)
+	}
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"rows":[]}`))
+	}))
+	defer server.Close()
+
+	client := moysklad.New(moysklad.Config{BaseURL: server.URL, Token: "test"})
+
+	// запрос с неверным выражением не выполняется
+	_, _, err := client.Entity().CustomerOrder().GetList(context.Background(), moysklad.WithFilterExpr(valid, invalid[0]))
+	if !errors.Is(err, filter.ErrInvalid) {
+		t.Fatalf("got error %v, want ErrInvalid", err)
+	}
+	if requests.Load() != 0 {
+		t.Fatalf("got %d requests, want 0", requests.Load())
+	}
+
+	if _, _, err = client.Entity().CustomerOrder().GetList(context.Background(), moysklad.WithFilterExpr(valid)); err != nil {
+		t.Fatal(err)
+	}
+	if requests.Load() != 1 {
+		t.Fatalf("got %d requests, want 1", requests.Load())
+	}
+}
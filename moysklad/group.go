@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Group Отдел.
@@ -110,6 +111,21 @@ type GroupService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Group], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех отделов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Group, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех отделов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Group]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех отделов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Group, error]
+
 	// Create выполняет запрос на создание отдела.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование отдела)
@@ -5,7 +5,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 	"reflect"
 
@@ -72,8 +71,8 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 			w.Write([]byte(v.Type().String()))
 		}
 
-		// special handling of Timestamp values
-		if v.Type() == reflect.TypeOf(Timestamp{}) {
+		// special handling of Timestamp and Money values
+		if v.Type() == reflect.TypeOf(Timestamp{}) || v.Type() == reflect.TypeOf(Money{}) {
 			fmt.Fprintf(w, "{%s}", v.Interface())
 			return
 		}
@@ -189,12 +188,10 @@ func UnmarshallAny[T any](data any) (T, error) {
 	var t T
 	b, err := json.Marshal(data)
 	if err != nil {
-		log.Println(err)
 		return t, err
 	}
 
 	if err = json.Unmarshal(b, &t); err != nil {
-		log.Println(err)
 		return t, err
 	}
 
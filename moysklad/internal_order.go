@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -17,7 +18,7 @@ import (
 type InternalOrder struct {
 	Organization          *Organization                     `json:"organization,omitempty"`          // Метаданные юрлица
 	Description           *string                           `json:"description,omitempty"`           // Комментарий Внутреннего заказа
-	VatSum                *float64                          `json:"vatSum,omitempty"`                // Сумма НДС
+	VatSum                *Money                            `json:"vatSum,omitempty"`                // Сумма НДС
 	AccountID             *string                           `json:"accountId,omitempty"`             // ID учётной записи
 	Created               *Timestamp                        `json:"created,omitempty"`               // Дата создания
 	Deleted               *Timestamp                        `json:"deleted,omitempty"`               // Момент последнего удаления Внутреннего заказа
@@ -42,7 +43,7 @@ type InternalOrder struct {
 	Shared                *bool                             `json:"shared,omitempty"`                // Общий доступ
 	State                 *NullValue[State]                 `json:"state,omitempty"`                 // Метаданные статуса Внутреннего заказа
 	Store                 *NullValue[Store]                 `json:"store,omitempty"`                 // Метаданные склада
-	Sum                   *float64                          `json:"sum,omitempty"`                   // Сумма Внутреннего заказа в копейках
+	Sum                   *Money                            `json:"sum,omitempty"`                   // Сумма Внутреннего заказа в копейках
 	SyncID                *string                           `json:"syncId,omitempty"`                // ID синхронизации
 	Updated               *Timestamp                        `json:"updated,omitempty"`               // Момент последнего обновления Внутреннего заказа
 	VatEnabled            *bool                             `json:"vatEnabled,omitempty"`            // Учитывается ли НДС
@@ -77,7 +78,7 @@ func (internalOrder InternalOrder) GetDescription() string {
 
 // GetVatSum возвращает Сумму НДС.
 func (internalOrder InternalOrder) GetVatSum() float64 {
-	return Deref(internalOrder.VatSum)
+	return Deref(internalOrder.VatSum).Float()
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -202,7 +203,7 @@ func (internalOrder InternalOrder) GetStore() Store {
 
 // GetSum возвращает Сумму Внутреннего заказа в копейках.
 func (internalOrder InternalOrder) GetSum() float64 {
-	return Deref(internalOrder.Sum)
+	return Deref(internalOrder.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -435,7 +436,7 @@ type InternalOrderPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
@@ -463,7 +464,7 @@ func (internalOrderPosition InternalOrderPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (internalOrderPosition InternalOrderPosition) GetPrice() float64 {
-	return Deref(internalOrderPosition.Price)
+	return Deref(internalOrderPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -501,7 +502,7 @@ func (internalOrderPosition *InternalOrderPosition) SetPack(pack *Pack) *Interna
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (internalOrderPosition *InternalOrderPosition) SetPrice(price float64) *InternalOrderPosition {
-	internalOrderPosition.Price = &price
+	internalOrderPosition.Price = NewMoney(MoneyFromFloat(price))
 	return internalOrderPosition
 }
 
@@ -545,6 +546,21 @@ type InternalOrderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[InternalOrder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех внутренних заказов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*InternalOrder, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех внутренних заказов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[InternalOrder]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех внутренних заказов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*InternalOrder, error]
+
 	// Create выполняет запрос на создание внутреннего заказа.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -555,13 +571,13 @@ type InternalOrderService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение внутренних заказов.
 	// Изменяемые внутренние заказы должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список внутренних заказов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых внутренних заказов.
-	CreateUpdateMany(ctx context.Context, internalOrderList Slice[InternalOrder], params ...func(*Params)) (*Slice[InternalOrder], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых внутренних заказов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, internalOrderList Slice[InternalOrder], params ...func(*Params)) (*BulkResult[InternalOrder], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление внутренних заказов.
 	// Принимает контекст и множество внутренних заказов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*InternalOrder) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*InternalOrder) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление внутреннего заказа по ID.
 	// Принимает контекст и ID внутреннего заказа.
@@ -600,6 +616,11 @@ type InternalOrderService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[InternalOrderPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*InternalOrderPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -617,8 +638,8 @@ type InternalOrderService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*InternalOrderPosition) (*Slice[InternalOrderPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*InternalOrderPosition) (*BulkResult[InternalOrderPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -630,6 +651,12 @@ type InternalOrderService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*InternalOrderPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[InternalOrderPosition], key func(position *InternalOrderPosition) string) (*PositionSyncResult[InternalOrderPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -663,8 +690,8 @@ type InternalOrderService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -678,8 +705,8 @@ type InternalOrderService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -733,8 +760,8 @@ type InternalOrderService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"net/http"
 	"time"
@@ -18,7 +19,7 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-inwentarizaciq
 type Inventory struct {
 	Name         *string                       `json:"name,omitempty"`         // Наименование Инвентаризации
-	Sum          *float64                      `json:"sum,omitempty"`          // Сумма Инвентаризации в копейках
+	Sum          *Money                        `json:"sum,omitempty"`          // Сумма Инвентаризации в копейках
 	Code         *string                       `json:"code,omitempty"`         // Код Инвентаризации
 	Created      *Timestamp                    `json:"created,omitempty"`      // Дата создания
 	Deleted      *Timestamp                    `json:"deleted,omitempty"`      // Момент последнего удаления Инвентаризации
@@ -67,7 +68,7 @@ func (inventory Inventory) GetName() string {
 
 // GetSum возвращает Сумму Инвентаризации в копейках.
 func (inventory Inventory) GetSum() float64 {
-	return Deref(inventory.Sum)
+	return Deref(inventory.Sum).Float()
 }
 
 // GetCode возвращает Код Инвентаризации.
@@ -355,10 +356,10 @@ type InventoryPosition struct {
 	Assortment         *AssortmentPosition `json:"assortment,omitempty"`         // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	CalculatedQuantity *float64            `json:"calculatedQuantity,omitempty"` // расчетный остаток
 	CorrectionAmount   *float64            `json:"correctionAmount,omitempty"`   // разница между расчетным остатком и фактическим
-	CorrectionSum      *float64            `json:"correctionSum,omitempty"`      // избыток/недостача
+	CorrectionSum      *Money              `json:"correctionSum,omitempty"`      // избыток/недостача
 	ID                 *string             `json:"id,omitempty"`                 // ID сущности
 	Pack               *Pack               `json:"pack,omitempty"`               // Упаковка Товара
-	Price              *float64            `json:"price,omitempty"`              // Цена товара/услуги в копейках
+	Price              *Money              `json:"price,omitempty"`              // Цена товара/услуги в копейках
 	Quantity           *float64            `json:"quantity,omitempty"`           // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 }
 
@@ -384,7 +385,7 @@ func (inventoryPosition InventoryPosition) GetCorrectionAmount() float64 {
 
 // GetCorrectionSum возвращает избыток/недостачу
 func (inventoryPosition InventoryPosition) GetCorrectionSum() float64 {
-	return Deref(inventoryPosition.CorrectionSum)
+	return Deref(inventoryPosition.CorrectionSum).Float()
 }
 
 // GetID возвращает ID позиции.
@@ -399,7 +400,7 @@ func (inventoryPosition InventoryPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (inventoryPosition InventoryPosition) GetPrice() float64 {
-	return Deref(inventoryPosition.Price)
+	return Deref(inventoryPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -436,7 +437,7 @@ func (inventoryPosition *InventoryPosition) SetPack(pack *Pack) *InventoryPositi
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (inventoryPosition *InventoryPosition) SetPrice(price float64) *InventoryPosition {
-	inventoryPosition.Price = &price
+	inventoryPosition.Price = NewMoney(MoneyFromFloat(price))
 	return inventoryPosition
 }
 
@@ -471,6 +472,21 @@ type InventoryService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Inventory], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех инвентаризаций.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Inventory, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех инвентаризаций.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Inventory]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех инвентаризаций асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Inventory, error]
+
 	// Create выполняет запрос на создание инвентаризации.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -482,13 +498,13 @@ type InventoryService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение инвентаризаций.
 	// Изменяемые инвентаризации должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список инвентаризации и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых инвентаризаций.
-	CreateUpdateMany(ctx context.Context, inventoryList Slice[Inventory], params ...func(*Params)) (*Slice[Inventory], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых инвентаризаций и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, inventoryList Slice[Inventory], params ...func(*Params)) (*BulkResult[Inventory], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление инвентаризаций.
 	// Принимает контекст и множество инвентаризаций.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Inventory) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Inventory) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление инвентаризации по ID.
 	// Принимает контекст и ID инвентаризации.
@@ -527,6 +543,11 @@ type InventoryService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[InventoryPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*InventoryPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -544,8 +565,8 @@ type InventoryService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*InventoryPosition) (*Slice[InventoryPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*InventoryPosition) (*BulkResult[InventoryPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -557,6 +578,12 @@ type InventoryService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*InventoryPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[InventoryPosition], key func(position *InventoryPosition) string) (*PositionSyncResult[InventoryPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -590,8 +617,8 @@ type InventoryService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -605,8 +632,8 @@ type InventoryService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetBySyncID выполняет запрос на получение отдельного документа по syncID.
 	// Принимает контекст и syncID документа.
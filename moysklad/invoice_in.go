@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -17,7 +18,7 @@ import (
 type InvoiceIn struct {
 	OrganizationAccount  *AgentAccount                 `json:"organizationAccount,omitempty"`  // Метаданные счета юрлица
 	Created              *Timestamp                    `json:"created,omitempty"`              // Дата создания
-	PayedSum             *float64                      `json:"payedSum,omitempty"`             // Сумма входящих платежей по Счету поставщика
+	PayedSum             *Money                        `json:"payedSum,omitempty"`             // Сумма входящих платежей по Счету поставщика
 	Applicable           *bool                         `json:"applicable,omitempty"`           // Отметка о проведении
 	Supplies             Slice[Supply]                 `json:"supplies,omitempty"`             // Ссылки на связанные приемки
 	Code                 *string                       `json:"code,omitempty"`                 // Код Счета поставщика
@@ -45,15 +46,15 @@ type InvoiceIn struct {
 	Published            *bool                         `json:"published,omitempty"`            // Опубликован ли документ
 	Rate                 *NullValue[Rate]              `json:"rate,omitempty"`                 // Валюта
 	Shared               *bool                         `json:"shared,omitempty"`               // Общий доступ
-	ShippedSum           *float64                      `json:"shippedSum,omitempty"`           // Сумма отгруженного
+	ShippedSum           *Money                        `json:"shippedSum,omitempty"`           // Сумма отгруженного
 	State                *NullValue[State]             `json:"state,omitempty"`                // Метаданные статуса счета поставщика
 	Store                *NullValue[Store]             `json:"store,omitempty"`                // Метаданные склада
-	Sum                  *float64                      `json:"sum,omitempty"`                  // Сумма Счета в установленной валюте
+	Sum                  *Money                        `json:"sum,omitempty"`                  // Сумма Счета в установленной валюте
 	SyncID               *string                       `json:"syncId,omitempty"`               // ID синхронизации
 	Updated              *Timestamp                    `json:"updated,omitempty"`              // Момент последнего обновления Счета поставщика
 	VatEnabled           *bool                         `json:"vatEnabled,omitempty"`           // Учитывается ли НДС
 	VatIncluded          *bool                         `json:"vatIncluded,omitempty"`          // Включен ли НДС в цену
-	VatSum               *float64                      `json:"vatSum,omitempty"`               // Сумма НДС
+	VatSum               *Money                        `json:"vatSum,omitempty"`               // Сумма НДС
 	Payments             Slice[Payment]                `json:"payments,omitempty"`             // Массив ссылок на связанные операции
 	PurchaseOrder        *PurchaseOrder                `json:"purchaseOrder,omitempty"`        // Ссылка на связанный заказ поставщику
 	Attributes           Slice[Attribute]              `json:"attributes,omitempty"`           // Список метаданных доп. полей
@@ -96,7 +97,7 @@ func (invoiceIn InvoiceIn) GetCreated() time.Time {
 
 // GetPayedSum возвращает Сумму входящих платежей по Счету поставщика.
 func (invoiceIn InvoiceIn) GetPayedSum() float64 {
-	return Deref(invoiceIn.PayedSum)
+	return Deref(invoiceIn.PayedSum).Float()
 }
 
 // GetApplicable возвращает Отметку о проведении.
@@ -236,7 +237,7 @@ func (invoiceIn InvoiceIn) GetShared() bool {
 
 // GetShippedSum возвращает Сумму отгруженного.
 func (invoiceIn InvoiceIn) GetShippedSum() float64 {
-	return Deref(invoiceIn.ShippedSum)
+	return Deref(invoiceIn.ShippedSum).Float()
 }
 
 // GetState возвращает Метаданные статуса счета поставщика.
@@ -251,7 +252,7 @@ func (invoiceIn InvoiceIn) GetStore() Store {
 
 // GetSum возвращает Сумму Счета поставщика в установленной валюте.
 func (invoiceIn InvoiceIn) GetSum() float64 {
-	return Deref(invoiceIn.Sum)
+	return Deref(invoiceIn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -276,7 +277,7 @@ func (invoiceIn InvoiceIn) GetVatIncluded() bool {
 
 // GetVatSum возвращает Сумму НДС.
 func (invoiceIn InvoiceIn) GetVatSum() float64 {
-	return Deref(invoiceIn.VatSum)
+	return Deref(invoiceIn.VatSum).Float()
 }
 
 // GetPayments возвращает Массив ссылок на связанные платежи.
@@ -554,7 +555,7 @@ type InvoiceInPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
@@ -590,7 +591,7 @@ func (invoiceInPosition InvoiceInPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (invoiceInPosition InvoiceInPosition) GetPrice() float64 {
-	return Deref(invoiceInPosition.Price)
+	return Deref(invoiceInPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в компоненте.
@@ -641,7 +642,7 @@ func (invoiceInPosition *InvoiceInPosition) SetPack(pack *Pack) *InvoiceInPositi
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (invoiceInPosition *InvoiceInPosition) SetPrice(price float64) *InvoiceInPosition {
-	invoiceInPosition.Price = &price
+	invoiceInPosition.Price = NewMoney(MoneyFromFloat(price))
 	return invoiceInPosition
 }
 
@@ -688,6 +689,21 @@ type InvoiceInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[InvoiceIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех счетов поставщиков.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*InvoiceIn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех счетов поставщиков.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[InvoiceIn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех счетов поставщиков асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*InvoiceIn, error]
+
 	// Create выполняет запрос на создание счета поставщика.
 	// Обязательные поля для заполнения:
 	//	- name (Номер Счета поставщика)
@@ -700,13 +716,13 @@ type InvoiceInService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение счетов поставщиков.
 	// Изменяемые счета поставщиков должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список счетов поставщиков и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых счетов поставщиков.
-	CreateUpdateMany(ctx context.Context, invoiceInList Slice[InvoiceIn], params ...func(*Params)) (*Slice[InvoiceIn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых счетов поставщиков и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, invoiceInList Slice[InvoiceIn], params ...func(*Params)) (*BulkResult[InvoiceIn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление счетов поставщиков.
 	// Принимает контекст и множество счетов поставщиков.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*InvoiceIn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*InvoiceIn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление счета поставщика по ID.
 	// Принимает контекст и ID счета поставщика.
@@ -753,6 +769,11 @@ type InvoiceInService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[InvoiceInPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*InvoiceInPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -770,8 +791,8 @@ type InvoiceInService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*InvoiceInPosition) (*Slice[InvoiceInPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*InvoiceInPosition) (*BulkResult[InvoiceInPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -783,6 +804,12 @@ type InvoiceInService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*InvoiceInPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[InvoiceInPosition], key func(position *InvoiceInPosition) string) (*PositionSyncResult[InvoiceInPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -816,8 +843,8 @@ type InvoiceInService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -831,8 +858,8 @@ type InvoiceInService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -886,8 +913,8 @@ type InvoiceInService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -15,7 +16,7 @@ import (
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-schet-pokupatelu
 type InvoiceOut struct {
-	PayedSum             *float64                       `json:"payedSum,omitempty"`             // Сумма входящих платежей по Счету покупателю
+	PayedSum             *Money                         `json:"payedSum,omitempty"`             // Сумма входящих платежей по Счету покупателю
 	VatEnabled           *bool                          `json:"vatEnabled,omitempty"`           // Учитывается ли НДС
 	AgentAccount         *AgentAccount                  `json:"agentAccount,omitempty"`         // Метаданные счета контрагента
 	Applicable           *bool                          `json:"applicable,omitempty"`           // Отметка о проведении
@@ -43,15 +44,15 @@ type InvoiceOut struct {
 	Published            *bool                          `json:"published,omitempty"`            // Опубликован ли документ
 	Rate                 *NullValue[Rate]               `json:"rate,omitempty"`                 // Валюта
 	Shared               *bool                          `json:"shared,omitempty"`               // Общий доступ
-	ShippedSum           *float64                       `json:"shippedSum,omitempty"`           // Сумма отгруженного
+	ShippedSum           *Money                         `json:"shippedSum,omitempty"`           // Сумма отгруженного
 	State                *NullValue[State]              `json:"state,omitempty"`                // Метаданные статуса счета
 	Store                *NullValue[Store]              `json:"store,omitempty"`                // Метаданные склада
-	Sum                  *float64                       `json:"sum,omitempty"`                  // Сумма Счета в установленной валюте
+	Sum                  *Money                         `json:"sum,omitempty"`                  // Сумма Счета в установленной валюте
 	SyncID               *string                        `json:"syncId,omitempty"`               // ID синхронизации
 	Updated              *Timestamp                     `json:"updated,omitempty"`              // Момент последнего обновления Счета покупателю
 	Owner                *Employee                      `json:"owner,omitempty"`                // Метаданные владельца (Сотрудника)
 	VatIncluded          *bool                          `json:"vatIncluded,omitempty"`          // Включен ли НДС в цену
-	VatSum               *float64                       `json:"vatSum,omitempty"`               // Сумма НДС
+	VatSum               *Money                         `json:"vatSum,omitempty"`               // Сумма НДС
 	CustomerOrder        *CustomerOrder                 `json:"customerOrder,omitempty"`        // Ссылка на Заказ Покупателя, с которым связан этот Счет покупателю
 	SalesChannel         *SalesChannel                  `json:"salesChannel,omitempty"`         // Метаданные канала продаж
 	Payments             Slice[Payment]                 `json:"payments,omitempty"`             // Массив ссылок на связанные операции
@@ -85,7 +86,7 @@ func (invoiceOut InvoiceOut) AsOperationIn() *Operation {
 
 // GetPayedSum возвращает Сумму входящих платежей по Счету покупателю.
 func (invoiceOut InvoiceOut) GetPayedSum() float64 {
-	return Deref(invoiceOut.PayedSum)
+	return Deref(invoiceOut.PayedSum).Float()
 }
 
 // GetVatEnabled возвращает true, если учитывается НДС.
@@ -225,7 +226,7 @@ func (invoiceOut InvoiceOut) GetShared() bool {
 
 // GetShippedSum возвращает Сумму отгруженного.
 func (invoiceOut InvoiceOut) GetShippedSum() float64 {
-	return Deref(invoiceOut.ShippedSum)
+	return Deref(invoiceOut.ShippedSum).Float()
 }
 
 // GetState возвращает Метаданные статуса счета.
@@ -240,7 +241,7 @@ func (invoiceOut InvoiceOut) GetStore() Store {
 
 // GetSum возвращает Сумму Счета покупателю в установленной валюте.
 func (invoiceOut InvoiceOut) GetSum() float64 {
-	return Deref(invoiceOut.Sum)
+	return Deref(invoiceOut.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -265,7 +266,7 @@ func (invoiceOut InvoiceOut) GetVatIncluded() bool {
 
 // GetVatSum возвращает Сумму НДС.
 func (invoiceOut InvoiceOut) GetVatSum() float64 {
-	return Deref(invoiceOut.VatSum)
+	return Deref(invoiceOut.VatSum).Float()
 }
 
 // GetCustomerOrder возвращает Ссылку на Заказ Покупателя, с которым связан этот Счет покупателю.
@@ -538,7 +539,7 @@ type InvoiceOutPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID сущности
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
@@ -574,7 +575,7 @@ func (invoiceOutPosition InvoiceOutPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (invoiceOutPosition InvoiceOutPosition) GetPrice() float64 {
-	return Deref(invoiceOutPosition.Price)
+	return Deref(invoiceOutPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -625,7 +626,7 @@ func (invoiceOutPosition *InvoiceOutPosition) SetPack(pack *Pack) *InvoiceOutPos
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (invoiceOutPosition *InvoiceOutPosition) SetPrice(price float64) *InvoiceOutPosition {
-	invoiceOutPosition.Price = &price
+	invoiceOutPosition.Price = NewMoney(MoneyFromFloat(price))
 	return invoiceOutPosition
 }
 
@@ -669,6 +670,21 @@ type InvoiceOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[InvoiceOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех счетов покупателям.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*InvoiceOut, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех счетов покупателям.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[InvoiceOut]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех счетов покупателям асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*InvoiceOut, error]
+
 	// Create выполняет запрос на создание счета покупателю.
 	// Обязательные поля для заполнения:
 	//	- name (Номер Счета покупателю)
@@ -681,13 +697,13 @@ type InvoiceOutService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение счетов покупателям.
 	// Изменяемые счета покупателям должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список счетов покупателям и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых счетов покупателям.
-	CreateUpdateMany(ctx context.Context, invoiceOutList Slice[InvoiceOut], params ...func(*Params)) (*Slice[InvoiceOut], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых счетов покупателям и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, invoiceOutList Slice[InvoiceOut], params ...func(*Params)) (*BulkResult[InvoiceOut], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление счетов покупателям.
 	// Принимает контекст и множество счетов покупателям.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*InvoiceOut) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*InvoiceOut) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление счета покупателю по ID.
 	// Принимает контекст и ID счета покупателю.
@@ -734,6 +750,11 @@ type InvoiceOutService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[InvoiceOutPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*InvoiceOutPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -751,8 +772,8 @@ type InvoiceOutService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*InvoiceOutPosition) (*Slice[InvoiceOutPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*InvoiceOutPosition) (*BulkResult[InvoiceOutPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -764,6 +785,12 @@ type InvoiceOutService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*InvoiceOutPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[InvoiceOutPosition], key func(position *InvoiceOutPosition) string) (*PositionSyncResult[InvoiceOutPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -797,8 +824,8 @@ type InvoiceOutService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -812,8 +839,8 @@ type InvoiceOutService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -867,8 +894,8 @@ type InvoiceOutService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -2,6 +2,7 @@ package moysklad
 
 import (
 	"encoding/json"
+	"iter"
 	"sync"
 )
 
@@ -65,6 +66,23 @@ func (iterator *Iterator[E]) Filter(f func(e *E) bool) *Iterator[E] {
 	return n
 }
 
+// All возвращает итератор по индексам и элементам итератора.
+//
+// # Пример:
+//
+//	for i, product := range products.Iter().All() {
+//		fmt.Println(i, product.GetName())
+//	}
+func (iterator *Iterator[E]) All() iter.Seq2[int, *E] {
+	return func(yield func(int, *E) bool) {
+		for i, el := range iterator.el {
+			if !yield(i, el) {
+				return
+			}
+		}
+	}
+}
+
 // Slice возвращает срез элементов.
 func (iterator *Iterator[E]) Slice() Slice[E] {
 	return iterator.el
@@ -0,0 +1,351 @@
+package moysklad
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRateLimit         = MaxQueriesPerSecond * 3 // Количество запросов за интервал по умолчанию
+	defaultRetryTimeInterval = 3 * time.Second         // Интервал ограничения по умолчанию
+)
+
+// Priority приоритет запроса при распределении мест параллельных запросов клиента.
+//
+// Возможные значения:
+//   - PriorityNormal – Обычный приоритет (по умолчанию)
+//   - PriorityHigh   – Высокий приоритет, например для запросов интерактивного интерфейса
+//   - PriorityBulk   – Низкий приоритет для массовых и фоновых операций
+type Priority int
+
+const (
+	PriorityNormal Priority = iota // Обычный приоритет
+	PriorityHigh                   // Высокий приоритет
+	PriorityBulk                   // Низкий приоритет
+)
+
+// priorities приоритеты в порядке выдачи мест ожидающим запросам.
+var priorities = [...]Priority{PriorityHigh, PriorityNormal, PriorityBulk}
+
+// String реализует интерфейс [fmt.Stringer].
+func (priority Priority) String() string {
+	switch priority {
+	case PriorityHigh:
+		return "high"
+	case PriorityBulk:
+		return "bulk"
+	default:
+		return "normal"
+	}
+}
+
+// priorityKey ключ приоритета в контексте.
+type priorityKey struct{}
+
+// WithPriority возвращает контекст, запросы с которым выполняются с приоритетом priority.
+//
+// Запросы с приоритетом [PriorityHigh] могут занять все места параллельных запросов,
+// [PriorityNormal] – все, кроме зарезервированных (см. Config.ReservedHighPriority),
+// [PriorityBulk] – не более Config.MaxBulkRequests мест.
+// Освободившееся место получает ожидающий запрос с наибольшим приоритетом.
+//
+// # Пример:
+//
+//	ctx = moysklad.WithPriority(ctx, moysklad.PriorityBulk)
+//	products, _, err := client.Entity().Product().GetListAll(ctx)
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext возвращает приоритет запросов контекста ctx (см. [WithPriority]).
+func PriorityFromContext(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return priority
+	}
+	return PriorityNormal
+}
+
+// RateLimits текущее состояние ограничений на количество запросов.
+type RateLimits struct {
+	Interval  time.Duration    // Минимальный интервал между запросами
+	Limit     int              // Количество запросов, которые можно сделать в течение интервала ограничения (X-RateLimit-Limit)
+	Remaining int              // Число запросов, которые можно отправить до получения 429 ошибки (X-RateLimit-Remaining)
+	Running   int              // Количество занятых мест параллельных запросов
+	Waiting   map[Priority]int // Количество запросов, ожидающих места, по приоритетам
+}
+
+// String реализует интерфейс [fmt.Stringer].
+func (rateLimits RateLimits) String() string {
+	return Stringify(rateLimits)
+}
+
+// queryLimits используется для ограничения количества параллельных запросов
+// и минимального интервала между ними.
+//
+// Интервал между запросами пересчитывается по заголовкам ответа
+// X-RateLimit-Limit, X-RateLimit-Remaining и X-Lognex-Retry-TimeInterval.
+type queryLimits struct {
+	slots     *prioritySlots // Места параллельных запросов
+	mu        sync.Mutex
+	next      time.Time     // Время, раньше которого нельзя отправить следующий запрос
+	interval  time.Duration // Минимальный интервал между запросами
+	limit     int           // Значение X-RateLimit-Limit из последнего ответа
+	remaining int           // Значение X-RateLimit-Remaining из последнего ответа
+	floor     time.Duration // Минимальный интервал между запросами, заданный в конфигурации
+}
+
+// newQueryLimits возвращает ограничения с количеством параллельных запросов concurrency,
+// из которых reserved зарезервированы для запросов с приоритетом [PriorityHigh],
+// не более bulk доступны запросам с приоритетом [PriorityBulk],
+// и минимальным интервалом между запросами floor.
+func newQueryLimits(concurrency, reserved, bulk int, floor time.Duration) *queryLimits {
+	return &queryLimits{
+		slots:     newPrioritySlots(concurrency, reserved, bulk),
+		floor:     floor,
+		interval:  max(defaultRetryTimeInterval/defaultRateLimit, floor),
+		limit:     defaultRateLimit,
+		remaining: defaultRateLimit,
+	}
+}
+
+// Wait занимает слот параллельного запроса с приоритетом из контекста и ожидает наступления времени отправки запроса.
+//
+// Возвращает ошибку ctx.Err() при отмене контекста. В этом случае слот освобождается.
+func (queryLimits *queryLimits) Wait(ctx context.Context) error {
+	if err := queryLimits.slots.acquire(ctx, PriorityFromContext(ctx)); err != nil {
+		return err
+	}
+
+	if err := sleepContext(ctx, queryLimits.reserve()); err != nil {
+		queryLimits.Done()
+		return err
+	}
+
+	return nil
+}
+
+// Done освобождает слот параллельного запроса.
+func (queryLimits *queryLimits) Done() {
+	queryLimits.slots.release()
+}
+
+// reserve резервирует время отправки запроса и возвращает задержку до него.
+func (queryLimits *queryLimits) reserve() time.Duration {
+	queryLimits.mu.Lock()
+	defer queryLimits.mu.Unlock()
+
+	now := time.Now()
+	at := queryLimits.next
+	if at.Before(now) {
+		at = now
+	}
+	queryLimits.next = at.Add(queryLimits.interval)
+
+	return at.Sub(now)
+}
+
+// update пересчитывает интервал между запросами по заголовкам ответа.
+//
+// Пока израсходовано меньше половины лимита, запросы отправляются равномерно (интервал / лимит).
+// Далее интервал растёт обратно пропорционально оставшемуся числу запросов.
+// При ответе 429 следующий запрос откладывается до сброса ограничения.
+func (queryLimits *queryLimits) update(resp *resty.Response) {
+	if resp == nil || resp.RawResponse == nil {
+		return
+	}
+
+	header := resp.Header()
+
+	rateLimit, err := strconv.Atoi(header.Get(headerRateLimit))
+	if err != nil || rateLimit <= 0 {
+		rateLimit = defaultRateLimit
+	}
+
+	rateRemaining, err := strconv.Atoi(header.Get(headerRateRemaining))
+	if err != nil {
+		rateRemaining = rateLimit
+	}
+
+	timeInterval := defaultRetryTimeInterval
+	if ms, err := strconv.Atoi(header.Get(headerRetryTimeInterval)); err == nil && ms > 0 {
+		timeInterval = time.Duration(ms) * time.Millisecond
+	}
+
+	interval := timeInterval / time.Duration(rateLimit)
+	if half := rateLimit / 2; rateRemaining < half {
+		interval = interval * time.Duration(half) / time.Duration(max(rateRemaining, 1))
+	}
+
+	queryLimits.mu.Lock()
+	defer queryLimits.mu.Unlock()
+
+	queryLimits.limit = rateLimit
+	queryLimits.remaining = rateRemaining
+	queryLimits.interval = max(min(interval, timeInterval), queryLimits.floor)
+
+	if resp.StatusCode() == http.StatusTooManyRequests {
+		if after := time.Now().Add(retryAfter(resp)); after.After(queryLimits.next) {
+			queryLimits.next = after
+		}
+	}
+}
+
+// state возвращает текущее состояние ограничений.
+func (queryLimits *queryLimits) state() RateLimits {
+	queryLimits.mu.Lock()
+	defer queryLimits.mu.Unlock()
+
+	running, waiting := queryLimits.slots.state()
+
+	return RateLimits{
+		Interval:  queryLimits.interval,
+		Limit:     queryLimits.limit,
+		Remaining: queryLimits.remaining,
+		Running:   running,
+		Waiting:   waiting,
+	}
+}
+
+// idle возвращает true, если нет выполняемых и ожидающих запросов.
+func (queryLimits *queryLimits) idle() bool {
+	running, waiting := queryLimits.slots.state()
+	for _, n := range waiting {
+		if n > 0 {
+			return false
+		}
+	}
+	return running == 0
+}
+
+// prioritySlots места параллельных запросов с учётом приоритетов.
+type prioritySlots struct {
+	mu       sync.Mutex
+	capacity int                          // Количество мест
+	reserved int                          // Количество мест, зарезервированных для PriorityHigh
+	bulk     int                          // Количество мест, доступных PriorityBulk
+	running  int                          // Количество занятых мест
+	waiting  map[Priority][]chan struct{} // Ожидающие запросы по приоритетам
+}
+
+// newPrioritySlots возвращает места параллельных запросов в количестве capacity.
+//
+// Количество зарезервированных мест reserved ограничивается так, чтобы обычным запросам оставалось хотя бы одно место.
+// Если bulk не указан, запросам с приоритетом [PriorityBulk] доступно на одно место меньше, чем обычным.
+func newPrioritySlots(capacity, reserved, bulk int) *prioritySlots {
+	reserved = min(max(reserved, 0), capacity-1)
+	normal := capacity - reserved
+	if bulk <= 0 {
+		bulk = normal - 1
+	}
+
+	return &prioritySlots{
+		capacity: capacity,
+		reserved: reserved,
+		bulk:     min(max(bulk, 1), normal),
+		waiting:  make(map[Priority][]chan struct{}),
+	}
+}
+
+// limit возвращает количество мест, доступных запросам с приоритетом priority.
+func (slots *prioritySlots) limit(priority Priority) int {
+	switch priority {
+	case PriorityHigh:
+		return slots.capacity
+	case PriorityBulk:
+		return slots.bulk
+	default:
+		return slots.capacity - slots.reserved
+	}
+}
+
+// blocked возвращает true, если есть ожидающие запросы с приоритетом не ниже priority.
+func (slots *prioritySlots) blocked(priority Priority) bool {
+	for _, p := range priorities {
+		if len(slots.waiting[p]) > 0 {
+			return true
+		}
+		if p == priority {
+			break
+		}
+	}
+	return false
+}
+
+// acquire занимает место для запроса с приоритетом priority.
+//
+// Возвращает ошибку ctx.Err() при отмене контекста.
+func (slots *prioritySlots) acquire(ctx context.Context, priority Priority) error {
+	slots.mu.Lock()
+	if !slots.blocked(priority) && slots.running < slots.limit(priority) {
+		slots.running++
+		slots.mu.Unlock()
+		return nil
+	}
+
+	ready := make(chan struct{})
+	slots.waiting[priority] = append(slots.waiting[priority], ready)
+	slots.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	slots.mu.Lock()
+	defer slots.mu.Unlock()
+
+	queue := slots.waiting[priority]
+	i := slices.Index(queue, ready)
+	if i < 0 {
+		// место уже выдано, освобождаем его
+		slots.running--
+		slots.dispatch()
+		return ctx.Err()
+	}
+
+	slots.waiting[priority] = slices.Delete(queue, i, i+1)
+	return ctx.Err()
+}
+
+// release освобождает место.
+func (slots *prioritySlots) release() {
+	slots.mu.Lock()
+	defer slots.mu.Unlock()
+
+	slots.running--
+	slots.dispatch()
+}
+
+// dispatch выдаёт свободные места ожидающим запросам в порядке убывания приоритета.
+func (slots *prioritySlots) dispatch() {
+	for _, priority := range priorities {
+		for len(slots.waiting[priority]) > 0 {
+			if slots.running >= slots.limit(priority) {
+				return
+			}
+
+			ready := slots.waiting[priority][0]
+			slots.waiting[priority] = slots.waiting[priority][1:]
+			slots.running++
+			close(ready)
+		}
+	}
+}
+
+// state возвращает количество занятых мест и количество ожидающих запросов по приоритетам.
+func (slots *prioritySlots) state() (int, map[Priority]int) {
+	slots.mu.Lock()
+	defer slots.mu.Unlock()
+
+	waiting := make(map[Priority]int, len(priorities))
+	for _, priority := range priorities {
+		waiting[priority] = len(slots.waiting[priority])
+	}
+	return slots.running, waiting
+}
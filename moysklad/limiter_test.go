@@ -0,0 +1,133 @@
+package moysklad
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPrioritySlotsLimits(t *testing.T) {
+	tests := []struct {
+		capacity, reserved, bulk int
+		high, normal, wantBulk   int
+	}{
+		{5, 0, 0, 5, 5, 4},
+		{5, 1, 0, 5, 4, 3},
+		{5, 1, 2, 5, 4, 2},
+		{5, 1, 10, 5, 4, 4},
+		{5, 10, 0, 5, 1, 1},
+		{1, 0, 0, 1, 1, 1},
+	}
+
+	for _, test := range tests {
+		slots := newPrioritySlots(test.capacity, test.reserved, test.bulk)
+
+		high, normal, bulk := slots.limit(PriorityHigh), slots.limit(PriorityNormal), slots.limit(PriorityBulk)
+		if high != test.high || normal != test.normal || bulk != test.wantBulk {
+			t.Errorf("newPrioritySlots(%d, %d, %d): got limits %d/%d/%d, want %d/%d/%d",
+				test.capacity, test.reserved, test.bulk, high, normal, bulk, test.high, test.normal, test.wantBulk)
+		}
+	}
+}
+
+// waitState ожидает, пока количество ожидающих запросов с приоритетом priority не станет равным n.
+func waitState(t *testing.T, limits *queryLimits, priority Priority, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for limits.state().Waiting[priority] != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d waiting %s requests, want %d", limits.state().Waiting[priority], priority, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestQueryLimitsPriority(t *testing.T) {
+	limits := newQueryLimits(2, 0, 0, 0)
+	ctx := context.Background()
+
+	// массовый запрос занимает единственное доступное ему место
+	if err := limits.slots.acquire(ctx, PriorityBulk); err != nil {
+		t.Fatal(err)
+	}
+
+	acquired := make(chan Priority, 3)
+	acquire := func(priority Priority) {
+		go func() {
+			if err := limits.slots.acquire(ctx, priority); err == nil {
+				acquired <- priority
+			}
+		}()
+	}
+
+	acquire(PriorityBulk)
+	waitState(t, limits, PriorityBulk, 1)
+
+	// обычный запрос получает второе место, несмотря на ожидающий массовый
+	acquire(PriorityNormal)
+	if got := <-acquired; got != PriorityNormal {
+		t.Fatalf("got %s request, want normal", got)
+	}
+
+	acquire(PriorityNormal)
+	waitState(t, limits, PriorityNormal, 1)
+	acquire(PriorityHigh)
+	waitState(t, limits, PriorityHigh, 1)
+
+	state := limits.state()
+	if state.Running != 2 {
+		t.Fatalf("got %d running requests, want 2", state.Running)
+	}
+	for priority, want := range map[Priority]int{PriorityHigh: 1, PriorityNormal: 1, PriorityBulk: 1} {
+		if state.Waiting[priority] != want {
+			t.Fatalf("got %d waiting %s requests, want %d", state.Waiting[priority], priority, want)
+		}
+	}
+
+	// освободившиеся места выдаются в порядке убывания приоритета
+	for _, want := range []Priority{PriorityHigh, PriorityNormal} {
+		limits.Done()
+		if got := <-acquired; got != want {
+			t.Fatalf("got %s request, want %s", got, want)
+		}
+	}
+
+	// массовый запрос ждёт, пока не освободится место в пределах его лимита
+	limits.Done()
+	if state = limits.state(); state.Running != 1 || state.Waiting[PriorityBulk] != 1 {
+		t.Fatalf("got %d running and %d waiting bulk requests, want 1 and 1", state.Running, state.Waiting[PriorityBulk])
+	}
+
+	limits.Done()
+	if got := <-acquired; got != PriorityBulk {
+		t.Fatalf("got %s request, want bulk", got)
+	}
+}
+
+func TestQueryLimitsCancel(t *testing.T) {
+	limits := newQueryLimits(1, 0, 0, 0)
+
+	if err := limits.slots.acquire(context.Background(), PriorityNormal); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- limits.slots.acquire(ctx, PriorityNormal) }()
+
+	waitState(t, limits, PriorityNormal, 1)
+	cancel()
+
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+	if waiting := limits.state().Waiting[PriorityNormal]; waiting != 0 {
+		t.Fatalf("got %d waiting requests after cancel, want 0", waiting)
+	}
+
+	limits.Done()
+	if !limits.idle() {
+		t.Fatal("got busy limits, want idle")
+	}
+}
@@ -0,0 +1,105 @@
+package moysklad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/mstest"
+)
+
+// seedProducts добавляет на сервер n товаров с названиями в порядке создания.
+func seedProducts(t *testing.T, server *mstest.Server, n int) {
+	t.Helper()
+
+	products := make([]any, 0, n)
+	for i := range n {
+		products = append(products, &moysklad.Product{Name: moysklad.String(fmt.Sprintf("%05d", i))})
+	}
+	if err := server.Seed(products...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetListAllOrder(t *testing.T) {
+	const total = 2500
+
+	server := mstest.NewServer(mstest.WithRateLimit(0, 0))
+	defer server.Close()
+
+	seedProducts(t, server, total)
+
+	client := server.Client(moysklad.Config{Token: "test"})
+	ctx := context.Background()
+
+	products, _, err := client.Entity().Product().GetListAll(ctx, moysklad.WithLimit(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if products.Len() != total {
+		t.Fatalf("got %d products, want %d", products.Len(), total)
+	}
+	for i, product := range *products {
+		if want := fmt.Sprintf("%05d", i); product.GetName() != want {
+			t.Fatalf("got product %s at %d, want %s", product.GetName(), i, want)
+		}
+	}
+
+	var i int
+	for product, err := range client.Entity().Product().GetListSeq(ctx, moysklad.WithLimit(100)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := fmt.Sprintf("%05d", i); product.GetName() != want {
+			t.Fatalf("got product %s at %d, want %s", product.GetName(), i, want)
+		}
+		i++
+	}
+	if i != total {
+		t.Fatalf("got %d products from sequence, want %d", i, total)
+	}
+}
+
+func TestGetListAllStablePagination(t *testing.T) {
+	const total = 2500
+
+	server := mstest.NewServer(mstest.WithRateLimit(0, 0))
+	defer server.Close()
+
+	seedProducts(t, server, total)
+
+	client := server.Client(moysklad.Config{Token: "test"})
+
+	// у большинства товаров совпадает момент обновления, окна запрашиваются со смещением
+	products, _, err := client.Entity().Product().GetListAll(context.Background(), moysklad.WithStablePagination())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[string]bool, total)
+	for _, product := range *products {
+		if seen[product.GetName()] {
+			t.Fatalf("got duplicate product %s", product.GetName())
+		}
+		seen[product.GetName()] = true
+	}
+	if len(seen) != total {
+		t.Fatalf("got %d products, want %d", len(seen), total)
+	}
+}
+
+func TestStablePaginationUnsupported(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+
+	_, _, err := client.Entity().CustomerOrder().GetPositionListAll(context.Background(), "00000000-0000-0000-0000-000000000000", moysklad.WithStablePagination())
+	if err == nil {
+		t.Fatal("got no error, want error for positions without updated")
+	}
+	if n := server.Requests(); n != 0 {
+		t.Fatalf("got %d requests, want 0", n)
+	}
+}
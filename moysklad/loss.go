@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -39,7 +40,7 @@ type Loss struct {
 	Shared       *bool                    `json:"shared,omitempty"`       // Общий доступ
 	State        *NullValue[State]        `json:"state,omitempty"`        // Метаданные статуса Списания
 	Store        *Store                   `json:"store,omitempty"`        // Метаданные склада
-	Sum          *float64                 `json:"sum,omitempty"`          // Сумма Списания в копейках
+	Sum          *Money                   `json:"sum,omitempty"`          // Сумма Списания в копейках
 	Name         *string                  `json:"name,omitempty"`         // Наименование Списания
 	Updated      *Timestamp               `json:"updated,omitempty"`      // Момент последнего обновления Списания
 	Inventory    *Inventory               `json:"inventory,omitempty"`    // Ссылка на связанную со списанием инвентаризацию
@@ -183,7 +184,7 @@ func (loss Loss) GetStore() Store {
 
 // GetSum возвращает Сумму Списания в копейках.
 func (loss Loss) GetSum() float64 {
-	return Deref(loss.Sum)
+	return Deref(loss.Sum).Float()
 }
 
 // GetName возвращает Наименование Списания.
@@ -380,7 +381,7 @@ type LossPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Reason     *string             `json:"reason,omitempty"`     // Причина списания данной позиции
 	Slot       *Slot               `json:"slot,omitempty"`       // Ячейка на складе
@@ -409,7 +410,7 @@ func (lossPosition LossPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (lossPosition LossPosition) GetPrice() float64 {
-	return Deref(lossPosition.Price)
+	return Deref(lossPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -455,7 +456,7 @@ func (lossPosition *LossPosition) SetPack(pack *Pack) *LossPosition {
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (lossPosition *LossPosition) SetPrice(price float64) *LossPosition {
-	lossPosition.Price = &price
+	lossPosition.Price = NewMoney(MoneyFromFloat(price))
 	return lossPosition
 }
 
@@ -515,6 +516,21 @@ type LossService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Loss], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех списаний.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Loss, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех списаний.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Loss]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех списаний асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Loss, error]
+
 	// Create выполняет запрос на создание списания.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -526,13 +542,13 @@ type LossService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение списаний.
 	// Изменяемые списания должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список списаний и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых списаний.
-	CreateUpdateMany(ctx context.Context, lossList Slice[Loss], params ...func(*Params)) (*Slice[Loss], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых списаний и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, lossList Slice[Loss], params ...func(*Params)) (*BulkResult[Loss], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление списаний.
 	// Принимает контекст и множество списаний.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Loss) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Loss) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление списания.
 	// Принимает контекст и списание.
@@ -579,6 +595,11 @@ type LossService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[LossPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*LossPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -596,8 +617,8 @@ type LossService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*LossPosition) (*Slice[LossPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*LossPosition) (*BulkResult[LossPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -609,6 +630,12 @@ type LossService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*LossPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[LossPosition], key func(position *LossPosition) string) (*PositionSyncResult[LossPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -642,8 +669,8 @@ type LossService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -657,8 +684,8 @@ type LossService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -712,8 +739,8 @@ type LossService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
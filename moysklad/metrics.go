@@ -0,0 +1,343 @@
+package moysklad
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics интерфейс сбора метрик запросов клиента.
+//
+// Методы вызываются из пути выполнения запроса и должны быть безопасны для параллельного вызова.
+// Значение endpoint приведено к шаблону (см. [EndpointTemplate]), например entity/customerorder/{id}.
+//
+// Встроенная реализация – [MetricsCollector].
+type Metrics interface {
+	// ObserveRequest вызывается после каждой попытки запроса.
+	// statusCode равен 0, если ответ не получен.
+	ObserveRequest(endpoint, method string, statusCode int, duration time.Duration)
+
+	// ObserveRetry вызывается перед повторной попыткой запроса.
+	ObserveRetry(endpoint, method string)
+
+	// ObserveLimiterWait вызывается после ожидания ограничений на количество запросов.
+	ObserveLimiterWait(endpoint string, wait time.Duration)
+
+	// AddInFlight изменяет количество выполняемых запросов на delta.
+	AddInFlight(delta int)
+
+	// SetMaxInFlight вызывается при создании клиента с максимальным количеством параллельных запросов клиента.
+	SetMaxInFlight(n int)
+}
+
+// nopMetrics реализация [Metrics], которая ничего не делает.
+type nopMetrics struct{}
+
+func (nopMetrics) ObserveRequest(string, string, int, time.Duration) {}
+func (nopMetrics) ObserveRetry(string, string)                       {}
+func (nopMetrics) ObserveLimiterWait(string, time.Duration)          {}
+func (nopMetrics) AddInFlight(int)                                   {}
+func (nopMetrics) SetMaxInFlight(int)                                {}
+
+// EndpointTemplate приводит путь запроса к шаблону, заменяя идентификаторы на {id}.
+//
+// Базовый адрес API и параметры запроса отбрасываются.
+//
+// # Пример:
+//
+//	moysklad.EndpointTemplate("https://api.moysklad.ru/api/remap/1.2/entity/customerorder/7944ef04-f831-11e5-7a69-971500188b19/positions")
+//	// entity/customerorder/{id}/positions
+func EndpointTemplate(path string) string {
+	if i := strings.Index(path, "/api/remap/1.2/"); i >= 0 {
+		path = path[i+len("/api/remap/1.2/"):]
+	}
+
+	path, _, _ = strings.Cut(path, "?")
+	path = strings.Trim(path, "/")
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if isIDSegment(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// isIDSegment возвращает true, если сегмент пути является UUID или числом.
+func isIDSegment(segment string) bool {
+	if segment == "" {
+		return false
+	}
+
+	isUUID := len(segment) == 36
+	isNumber := true
+
+	for i, r := range segment {
+		switch {
+		case i == 8 || i == 13 || i == 18 || i == 23:
+			isUUID = isUUID && r == '-'
+			isNumber = false
+		case r >= '0' && r <= '9':
+		case r >= 'a' && r <= 'f', r >= 'A' && r <= 'F':
+			isNumber = false
+		default:
+			return false
+		}
+	}
+
+	return isUUID || isNumber
+}
+
+// DefaultMetricsBuckets границы интервалов гистограмм [MetricsCollector] по умолчанию (в секундах).
+var DefaultMetricsBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// MetricsCollector встроенная реализация [Metrics], которая хранит метрики в памяти
+// и отдаёт их в текстовом формате Prometheus.
+//
+// Реализует интерфейс [http.Handler]:
+//
+//	metrics := moysklad.NewMetricsCollector()
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token:   "MS_TOKEN_HERE",
+//		Metrics: metrics,
+//	})
+//
+//	http.Handle("/metrics", metrics)
+//
+// Метрики:
+//   - moysklad_requests_total – количество запросов по endpoint, method, status
+//   - moysklad_request_duration_seconds – время выполнения запросов по endpoint, method
+//   - moysklad_rate_limited_total – количество ответов 429 по endpoint, method
+//   - moysklad_retries_total – количество повторных попыток по endpoint, method
+//   - moysklad_limiter_wait_seconds – время ожидания ограничений на количество запросов по endpoint
+//   - moysklad_in_flight_requests – количество выполняемых запросов
+//   - moysklad_max_in_flight_requests – максимальное количество параллельных запросов клиента
+//     (см. [Config.MaxConcurrentRequests]; если сборщик общий для нескольких клиентов, значение последнего созданного)
+type MetricsCollector struct {
+	mu          sync.Mutex
+	buckets     []float64
+	requests    map[[3]string]uint64
+	durations   map[[2]string]*histogram
+	rateLimited map[[2]string]uint64
+	retries     map[[2]string]uint64
+	waits       map[string]*histogram
+	inFlight    int
+	maxInFlight int
+}
+
+// NewMetricsCollector возвращает [MetricsCollector].
+//
+// Принимает границы интервалов гистограмм в секундах. Если не указаны, используются [DefaultMetricsBuckets].
+func NewMetricsCollector(buckets ...float64) *MetricsCollector {
+	if len(buckets) == 0 {
+		buckets = DefaultMetricsBuckets
+	}
+
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+
+	return &MetricsCollector{
+		buckets:     buckets,
+		requests:    make(map[[3]string]uint64),
+		durations:   make(map[[2]string]*histogram),
+		rateLimited: make(map[[2]string]uint64),
+		retries:     make(map[[2]string]uint64),
+		waits:       make(map[string]*histogram),
+	}
+}
+
+// ObserveRequest реализует интерфейс [Metrics].
+func (metricsCollector *MetricsCollector) ObserveRequest(endpoint, method string, statusCode int, duration time.Duration) {
+	metricsCollector.mu.Lock()
+	defer metricsCollector.mu.Unlock()
+
+	metricsCollector.requests[[3]string{endpoint, method, strconv.Itoa(statusCode)}]++
+
+	key := [2]string{endpoint, method}
+	if statusCode == http.StatusTooManyRequests {
+		metricsCollector.rateLimited[key]++
+	}
+
+	h, ok := metricsCollector.durations[key]
+	if !ok {
+		h = newHistogram(metricsCollector.buckets)
+		metricsCollector.durations[key] = h
+	}
+	h.observe(duration.Seconds())
+}
+
+// ObserveRetry реализует интерфейс [Metrics].
+func (metricsCollector *MetricsCollector) ObserveRetry(endpoint, method string) {
+	metricsCollector.mu.Lock()
+	defer metricsCollector.mu.Unlock()
+
+	metricsCollector.retries[[2]string{endpoint, method}]++
+}
+
+// ObserveLimiterWait реализует интерфейс [Metrics].
+func (metricsCollector *MetricsCollector) ObserveLimiterWait(endpoint string, wait time.Duration) {
+	metricsCollector.mu.Lock()
+	defer metricsCollector.mu.Unlock()
+
+	h, ok := metricsCollector.waits[endpoint]
+	if !ok {
+		h = newHistogram(metricsCollector.buckets)
+		metricsCollector.waits[endpoint] = h
+	}
+	h.observe(wait.Seconds())
+}
+
+// AddInFlight реализует интерфейс [Metrics].
+func (metricsCollector *MetricsCollector) AddInFlight(delta int) {
+	metricsCollector.mu.Lock()
+	defer metricsCollector.mu.Unlock()
+
+	metricsCollector.inFlight += delta
+}
+
+// SetMaxInFlight реализует интерфейс [Metrics].
+func (metricsCollector *MetricsCollector) SetMaxInFlight(n int) {
+	metricsCollector.mu.Lock()
+	defer metricsCollector.mu.Unlock()
+
+	metricsCollector.maxInFlight = n
+}
+
+// ServeHTTP реализует интерфейс [http.Handler].
+func (metricsCollector *MetricsCollector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = metricsCollector.WritePrometheus(w)
+}
+
+// WritePrometheus выводит метрики в текстовом формате Prometheus.
+func (metricsCollector *MetricsCollector) WritePrometheus(w io.Writer) error {
+	metricsCollector.mu.Lock()
+	defer metricsCollector.mu.Unlock()
+
+	var b strings.Builder
+
+	writeHeader(&b, "moysklad_requests_total", "counter", "Количество запросов.")
+	for _, key := range sortedKeys(metricsCollector.requests) {
+		writeSample(&b, "moysklad_requests_total", labels("endpoint", key[0], "method", key[1], "status", key[2]), float64(metricsCollector.requests[key]))
+	}
+
+	writeHeader(&b, "moysklad_request_duration_seconds", "histogram", "Время выполнения запросов.")
+	for _, key := range sortedKeys(metricsCollector.durations) {
+		metricsCollector.durations[key].write(&b, "moysklad_request_duration_seconds", "endpoint", key[0], "method", key[1])
+	}
+
+	writeHeader(&b, "moysklad_rate_limited_total", "counter", "Количество ответов 429 (Too Many Requests).")
+	for _, key := range sortedKeys(metricsCollector.rateLimited) {
+		writeSample(&b, "moysklad_rate_limited_total", labels("endpoint", key[0], "method", key[1]), float64(metricsCollector.rateLimited[key]))
+	}
+
+	writeHeader(&b, "moysklad_retries_total", "counter", "Количество повторных попыток запросов.")
+	for _, key := range sortedKeys(metricsCollector.retries) {
+		writeSample(&b, "moysklad_retries_total", labels("endpoint", key[0], "method", key[1]), float64(metricsCollector.retries[key]))
+	}
+
+	writeHeader(&b, "moysklad_limiter_wait_seconds", "histogram", "Время ожидания ограничений на количество запросов.")
+	for _, key := range sortedKeys(metricsCollector.waits) {
+		metricsCollector.waits[key].write(&b, "moysklad_limiter_wait_seconds", "endpoint", key)
+	}
+
+	writeHeader(&b, "moysklad_in_flight_requests", "gauge", "Количество выполняемых запросов.")
+	writeSample(&b, "moysklad_in_flight_requests", "", float64(metricsCollector.inFlight))
+
+	writeHeader(&b, "moysklad_max_in_flight_requests", "gauge", "Максимальное количество параллельных запросов.")
+	writeSample(&b, "moysklad_max_in_flight_requests", "", float64(metricsCollector.maxInFlight))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// histogram гистограмма с накопительными интервалами.
+type histogram struct {
+	buckets []float64
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+}
+
+// observe добавляет значение v в гистограмму.
+func (histogram *histogram) observe(v float64) {
+	for i, bound := range histogram.buckets {
+		if v <= bound {
+			histogram.counts[i]++
+		}
+	}
+	histogram.sum += v
+	histogram.count++
+}
+
+// write выводит гистограмму с метками pairs в текстовом формате Prometheus.
+func (histogram *histogram) write(b *strings.Builder, name string, pairs ...string) {
+	for i, bound := range histogram.buckets {
+		writeSample(b, name+"_bucket", labels(append(pairs, "le", formatFloat(bound))...), float64(histogram.counts[i]))
+	}
+	writeSample(b, name+"_bucket", labels(append(pairs, "le", "+Inf")...), float64(histogram.count))
+	writeSample(b, name+"_sum", labels(pairs...), histogram.sum)
+	writeSample(b, name+"_count", labels(pairs...), float64(histogram.count))
+}
+
+// writeHeader выводит описание и тип метрики.
+func writeHeader(b *strings.Builder, name, kind, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// writeSample выводит значение метрики.
+func writeSample(b *strings.Builder, name, labels string, value float64) {
+	fmt.Fprintf(b, "%s%s %s\n", name, labels, formatFloat(value))
+}
+
+// labels возвращает метки в формате {name="value",...} из пар имя-значение.
+func labels(pairs ...string) string {
+	if len(pairs) == 0 {
+		return ""
+	}
+
+	parts := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		parts = append(parts, pairs[i]+`="`+escapeLabel(pairs[i+1])+`"`)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// escapeLabel экранирует значение метки.
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// formatFloat возвращает число в формате Prometheus.
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// metricKey ключ значения метрики: метки в порядке их вывода.
+type metricKey interface {
+	~string | ~[2]string | ~[3]string
+}
+
+// sortedKeys возвращает отсортированные ключи map.
+func sortedKeys[K metricKey, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b K) int {
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	})
+	return keys
+}
@@ -0,0 +1,81 @@
+package moysklad_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"https://api.moysklad.ru/api/remap/1.2/entity/customerorder/7944ef04-f831-11e5-7a69-971500188b19/positions", "entity/customerorder/{id}/positions"},
+		{"entity/customerorder/7944EF04-F831-11E5-7A69-971500188B19", "entity/customerorder/{id}"},
+		{"/entity/product/7944ef04-f831-11e5-7a69-971500188b19/?limit=10", "entity/product/{id}"},
+		{"entity/customerorder/7944ef04-f831-11e5-7a69-971500188b19/positions/7944ef04-f831-11e5-7a69-971500188b20", "entity/customerorder/{id}/positions/{id}"},
+		{"entity/webhook/12345", "entity/webhook/{id}"},
+		{"entity/customerorder/delete", "entity/customerorder/delete"},
+		{"entity/customerorder/metadata/attributes", "entity/customerorder/metadata/attributes"},
+		// строки длиной 36 символов без дефисов на своих местах не являются UUID
+		{"entity/product/7944ef04xf831-11e5-7a69-971500188b19", "entity/product/7944ef04xf831-11e5-7a69-971500188b19"},
+		{"entity/product/7944ef04-f831-11e5-7a69-971500188b1g", "entity/product/7944ef04-f831-11e5-7a69-971500188b1g"},
+		{"entity/product/abcdef", "entity/product/abcdef"},
+	}
+
+	for _, test := range tests {
+		if got := moysklad.EndpointTemplate(test.path); got != test.want {
+			t.Errorf("EndpointTemplate(%q): got %q, want %q", test.path, got, test.want)
+		}
+	}
+}
+
+func TestMetricsCollectorPrometheus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"product"}`))
+	}))
+	defer server.Close()
+
+	metrics := moysklad.NewMetricsCollector(0.5, 1)
+	client := moysklad.New(moysklad.Config{
+		BaseURL:               server.URL,
+		Token:                 "test",
+		Metrics:               metrics,
+		MaxConcurrentRequests: 2,
+	})
+
+	if _, _, err := client.Entity().Product().GetByID(context.Background(), "7944ef04-f831-11e5-7a69-971500188b19"); err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := metrics.WritePrometheus(&b); err != nil {
+		t.Fatal(err)
+	}
+	output := b.String()
+
+	for _, want := range []string{
+		"# TYPE moysklad_requests_total counter\n",
+		`moysklad_requests_total{endpoint="entity/product/{id}",method="GET",status="200"} 1` + "\n",
+		"# TYPE moysklad_request_duration_seconds histogram\n",
+		`moysklad_request_duration_seconds_bucket{endpoint="entity/product/{id}",method="GET",le="+Inf"} 1` + "\n",
+		`moysklad_request_duration_seconds_count{endpoint="entity/product/{id}",method="GET"} 1` + "\n",
+		"moysklad_in_flight_requests 0\n",
+		// значение задаётся ограничениями клиента, а не MaxQueriesPerUser
+		"moysklad_max_in_flight_requests 2\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("got output without %q:\n%s", want, output)
+		}
+	}
+
+	if strings.Contains(output, "moysklad_rate_limited_total{") || strings.Contains(output, "moysklad_retries_total{") {
+		t.Errorf("got unexpected samples:\n%s", output)
+	}
+}
@@ -0,0 +1,88 @@
+package moysklad
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RequestInfo сведения о запросе, передаваемые в [Middleware].
+type RequestInfo struct {
+	Method  string      // Метод запроса
+	Path    string      // Путь запроса относительно базового адреса API или полный URL
+	Query   url.Values  // Параметры запроса
+	Header  http.Header // Заголовки запроса. Могут быть изменены до вызова следующего обработчика
+	Attempt int         // Номер попытки (начиная с 1), см. [RetryPolicy]
+	Async   bool        // Запрос на создание асинхронной задачи
+}
+
+// ResponseInfo сведения об ответе, передаваемые в [Middleware].
+type ResponseInfo struct {
+	StatusCode    int             // HTTP статус ответа
+	Duration      time.Duration   // Время выполнения запроса без учёта ожидания ограничений на количество запросов
+	RateLimit     int             // Количество запросов, которые можно сделать в течение интервала ограничения (X-RateLimit-Limit)
+	RateRemaining int             // Число запросов, которые можно отправить до получения 429 ошибки (X-RateLimit-Remaining)
+	RetryInterval time.Duration   // Интервал ограничения (X-Lognex-Retry-TimeInterval)
+	RetryAfter    time.Duration   // Время до сброса ограничения (X-Lognex-Retry-After)
+	Response      *resty.Response // Ответ
+}
+
+// Handler выполняет запрос и возвращает сведения об ответе.
+type Handler func(ctx context.Context, request *RequestInfo) (*ResponseInfo, error)
+
+// Middleware оборачивает обработчик запросов.
+//
+// Вызывается для каждой попытки выполнения запроса, в том числе для запросов на создание асинхронных задач.
+// Позволяет подключить метрики, трассировку или журналирование.
+//
+// # Пример:
+//
+//	func Timing(next moysklad.Handler) moysklad.Handler {
+//		return func(ctx context.Context, request *moysklad.RequestInfo) (*moysklad.ResponseInfo, error) {
+//			response, err := next(ctx, request)
+//			if response != nil {
+//				fmt.Println(request.Method, request.Path, response.StatusCode, response.Duration)
+//			}
+//			return response, err
+//		}
+//	}
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token:       "MS_TOKEN_HERE",
+//		Middlewares: []moysklad.Middleware{Timing},
+//	})
+type Middleware func(next Handler) Handler
+
+// chain оборачивает обработчик handler в middlewares. Первый элемент middlewares вызывается первым.
+func chain(handler Handler, middlewares []Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+// newResponseInfo возвращает сведения об ответе resp.
+func newResponseInfo(resp *resty.Response, duration time.Duration) *ResponseInfo {
+	if resp == nil || resp.RawResponse == nil {
+		return &ResponseInfo{Duration: duration, Response: resp}
+	}
+
+	header := resp.Header()
+	rateLimit, _ := strconv.Atoi(header.Get(headerRateLimit))
+	rateRemaining, _ := strconv.Atoi(header.Get(headerRateRemaining))
+	retryInterval, _ := strconv.Atoi(header.Get(headerRetryTimeInterval))
+	retryAfter, _ := strconv.Atoi(header.Get(headerRetryAfter))
+
+	return &ResponseInfo{
+		StatusCode:    resp.StatusCode(),
+		Duration:      duration,
+		RateLimit:     rateLimit,
+		RateRemaining: rateRemaining,
+		RetryInterval: time.Duration(retryInterval) * time.Millisecond,
+		RetryAfter:    time.Duration(retryAfter) * time.Millisecond,
+		Response:      resp,
+	}
+}
@@ -0,0 +1,425 @@
+package moysklad
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode Способ округления до копеек.
+//
+// Возможные значения:
+//   - RoundHalfUp   – к ближайшему, половина округляется от нуля
+//   - RoundHalfEven – к ближайшему, половина округляется к чётному (банковское округление)
+//   - RoundDown     – к нулю (отбрасывание дробной части)
+//   - RoundUp       – от нуля
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // к ближайшему, половина округляется от нуля
+	RoundHalfEven                     // к ближайшему, половина округляется к чётному
+	RoundDown                         // к нулю
+	RoundUp                           // от нуля
+)
+
+var (
+	// ErrMoneyOverflow возвращается, если сумма не помещается в int64 копеек.
+	ErrMoneyOverflow = errors.New("moysklad: money overflow")
+
+	// ErrMoneyDivisionByZero возвращается при делении суммы на ноль.
+	ErrMoneyDivisionByZero = errors.New("moysklad: money division by zero")
+)
+
+// Money Денежная сумма в копейках (сотых долях единицы валюты).
+//
+// API МойСклад передаёт суммы и цены числом в копейках. Money хранит целое число копеек,
+// поэтому сложение и вычитание выполняются без погрешности, а умножение и деление
+// выполняются точно и округляются указанным способом [RoundingMode].
+// Арифметические методы возвращают [ErrMoneyOverflow], если результат не помещается в int64 копеек.
+//
+// Money не подходит для средних значений и себестоимости единицы товара: сервер передаёт их
+// с долями копейки, поэтому такие поля имеют тип float64.
+//
+// Валюта и курс не передаются в JSON и используются для пересчёта в валюту учёта и форматирования.
+type Money struct {
+	Currency *Currency // Валюта суммы
+	Rate     float64   // Курс валюты суммы к валюте учёта
+	Amount   int64     // Сумма в копейках
+}
+
+// Kopecks возвращает сумму amount в копейках.
+func Kopecks(amount int64) Money {
+	return Money{Amount: amount}
+}
+
+// MoneyFromFloat возвращает сумму amount в копейках, округлённую до целой копейки по правилу [RoundHalfUp].
+func MoneyFromFloat(amount float64) Money {
+	return Money{Amount: int64(math.Round(amount))}
+}
+
+// NewMoney принимает [Money] и возвращает указатель на копию.
+func NewMoney(money Money) *Money {
+	return &money
+}
+
+// ParseMoney разбирает сумму в рублях (единицах валюты) из строки, например "1234.56", "1 234,56" или "-0.5".
+//
+// Возвращает ошибку, если сумма содержит больше двух знаков после запятой.
+func ParseMoney(value string) (Money, error) {
+	value = strings.NewReplacer(" ", "", " ", "", ",", ".").Replace(strings.TrimSpace(value))
+
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return Money{}, fmt.Errorf("moysklad: invalid money value %q", value)
+	}
+
+	r.Mul(r, big.NewRat(100, 1))
+	if !r.IsInt() {
+		return Money{}, fmt.Errorf("moysklad: money value %q has more than two decimal places", value)
+	}
+	if !r.Num().IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{Amount: r.Num().Int64()}, nil
+}
+
+// WithCurrency возвращает сумму в валюте currency с курсом rate к валюте учёта.
+func (money Money) WithCurrency(currency *Currency, rate float64) Money {
+	money.Currency = currency
+	money.Rate = rate
+	return money
+}
+
+// Float возвращает сумму в копейках в виде числа с плавающей точкой.
+func (money Money) Float() float64 {
+	return float64(money.Amount)
+}
+
+// Roubles возвращает сумму в рублях (единицах валюты) в виде числа с плавающей точкой.
+func (money Money) Roubles() float64 {
+	return float64(money.Amount) / 100
+}
+
+// IsZero возвращает true, если сумма равна нулю.
+func (money Money) IsZero() bool {
+	return money.Amount == 0
+}
+
+// IsNegative возвращает true, если сумма меньше нуля.
+func (money Money) IsNegative() bool {
+	return money.Amount < 0
+}
+
+// Sign возвращает -1, 0 или 1 в зависимости от знака суммы.
+func (money Money) Sign() int {
+	switch {
+	case money.Amount < 0:
+		return -1
+	case money.Amount > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Cmp сравнивает суммы без учёта валюты. Возвращает -1, 0 или 1.
+func (money Money) Cmp(other Money) int {
+	switch {
+	case money.Amount < other.Amount:
+		return -1
+	case money.Amount > other.Amount:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Add возвращает сумму money + other.
+//
+// Возвращает [ErrMoneyOverflow] при переполнении.
+func (money Money) Add(other Money) (Money, error) {
+	amount := money.Amount + other.Amount
+	// переполнение возможно только при сложении чисел одного знака
+	if (other.Amount > 0 && amount < money.Amount) || (other.Amount < 0 && amount > money.Amount) {
+		return Money{}, ErrMoneyOverflow
+	}
+	money.Amount = amount
+	return money, nil
+}
+
+// Sub возвращает разность money - other.
+//
+// Возвращает [ErrMoneyOverflow] при переполнении.
+func (money Money) Sub(other Money) (Money, error) {
+	amount := money.Amount - other.Amount
+	if (other.Amount > 0 && amount > money.Amount) || (other.Amount < 0 && amount < money.Amount) {
+		return Money{}, ErrMoneyOverflow
+	}
+	money.Amount = amount
+	return money, nil
+}
+
+// Neg возвращает сумму с противоположным знаком.
+func (money Money) Neg() Money {
+	money.Amount = -money.Amount
+	return money
+}
+
+// Abs возвращает абсолютное значение суммы.
+func (money Money) Abs() Money {
+	if money.Amount < 0 {
+		money.Amount = -money.Amount
+	}
+	return money
+}
+
+// Mul возвращает произведение суммы на целое число n.
+//
+// Возвращает [ErrMoneyOverflow] при переполнении.
+func (money Money) Mul(n int64) (Money, error) {
+	r := new(big.Rat).SetInt64(money.Amount)
+	r.Mul(r, new(big.Rat).SetInt64(n))
+	return money.withRat(r, RoundDown)
+}
+
+// MulFloat возвращает произведение суммы на число f (например, количество), округлённое способом mode.
+//
+// Число f используется точно, без погрешности десятичного представления.
+// Возвращает [ErrMoneyOverflow] при переполнении.
+func (money Money) MulFloat(f float64, mode RoundingMode) (Money, error) {
+	r := new(big.Rat).SetInt64(money.Amount)
+	r.Mul(r, ratFromFloat(f))
+	return money.withRat(r, mode)
+}
+
+// Div возвращает частное суммы и целого числа n, округлённое способом mode.
+//
+// Возвращает [ErrMoneyDivisionByZero], если n равно нулю.
+func (money Money) Div(n int64, mode RoundingMode) (Money, error) {
+	if n == 0 {
+		return Money{}, ErrMoneyDivisionByZero
+	}
+	return money.withRat(big.NewRat(money.Amount, n), mode)
+}
+
+// Percent возвращает percent процентов от суммы, округлённые способом mode.
+//
+// Возвращает [ErrMoneyOverflow] при переполнении.
+func (money Money) Percent(percent float64, mode RoundingMode) (Money, error) {
+	r := new(big.Rat).SetInt64(money.Amount)
+	r.Mul(r, ratFromFloat(percent))
+	r.Quo(r, big.NewRat(100, 1))
+	return money.withRat(r, mode)
+}
+
+// withRat возвращает сумму, равную числу r, округлённому способом mode, с валютой и курсом исходной суммы.
+func (money Money) withRat(r *big.Rat, mode RoundingMode) (Money, error) {
+	amount, err := roundRat(r, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	money.Amount = amount
+	return money, nil
+}
+
+// Allocate распределяет сумму на n частей, отличающихся не более чем на одну копейку.
+//
+// Сумма частей равна исходной сумме.
+func (money Money) Allocate(n int) []Money {
+	if n <= 0 {
+		return nil
+	}
+
+	parts := make([]Money, n)
+	quotient, remainder := money.Amount/int64(n), money.Amount%int64(n)
+
+	for i := range parts {
+		parts[i] = money
+		parts[i].Amount = quotient
+		switch {
+		case remainder > 0:
+			parts[i].Amount++
+			remainder--
+		case remainder < 0:
+			parts[i].Amount--
+			remainder++
+		}
+	}
+
+	return parts
+}
+
+// InBase возвращает сумму в валюте учёта по курсу Rate, округлённую способом mode.
+//
+// Если курс не указан, возвращается исходная сумма без валюты.
+// Возвращает [ErrMoneyOverflow] при переполнении.
+func (money Money) InBase(mode RoundingMode) (Money, error) {
+	if money.Rate == 0 || money.Rate == 1 {
+		return Money{Amount: money.Amount}, nil
+	}
+	base, err := money.MulFloat(money.Rate, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: base.Amount}, nil
+}
+
+// String реализует интерфейс [fmt.Stringer].
+//
+// Возвращает сумму в рублях (единицах валюты) с двумя знаками после точки, например "1234.56".
+// Если указана валюта, добавляет её буквенный код.
+func (money Money) String() string {
+	s := money.format("", ".")
+	if code := money.currencyCode(); code != "" {
+		s += " " + code
+	}
+	return s
+}
+
+// Format возвращает сумму в рублях (единицах валюты) с разделителем разрядов и запятой, например "1 234,56".
+// Если указана валюта, добавляет её буквенный код.
+func (money Money) Format() string {
+	s := money.format(" ", ",")
+	if code := money.currencyCode(); code != "" {
+		s += " " + code
+	}
+	return s
+}
+
+// format возвращает сумму в единицах валюты с разделителем разрядов group и дробной части decimal.
+func (money Money) format(group, decimal string) string {
+	amount := money.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+
+	// abs без переполнения для math.MinInt64
+	abs := new(big.Int).Abs(big.NewInt(amount))
+	digits := abs.String()
+	for len(digits) < 3 {
+		digits = "0" + digits
+	}
+
+	integer, fraction := digits[:len(digits)-2], digits[len(digits)-2:]
+
+	if group != "" {
+		var b strings.Builder
+		for i, d := range integer {
+			if i > 0 && (len(integer)-i)%3 == 0 {
+				b.WriteString(group)
+			}
+			b.WriteRune(d)
+		}
+		integer = b.String()
+	}
+
+	return sign + integer + decimal + fraction
+}
+
+// currencyCode возвращает буквенный код валюты.
+func (money Money) currencyCode() string {
+	if money.Currency == nil {
+		return ""
+	}
+	return money.Currency.GetISOCode()
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+//
+// Сумма передаётся числом в копейках.
+func (money Money) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, money.Amount, 10), nil
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+//
+// Дробная часть копейки округляется по правилу [RoundHalfUp]. Значение null разбирается в нулевую сумму.
+// Возвращает [ErrMoneyOverflow], если сумма не помещается в int64 копеек.
+func (money *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*money = Money{}
+		return nil
+	}
+
+	// число может быть передано строкой
+	data = bytes.Trim(data, `"`)
+
+	if amount, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		*money = Money{Amount: amount}
+		return nil
+	}
+
+	r, ok := new(big.Rat).SetString(string(data))
+	if !ok {
+		return fmt.Errorf("moysklad: invalid money value %s", data)
+	}
+
+	amount, err := roundRat(r, RoundHalfUp)
+	if err != nil {
+		return err
+	}
+
+	*money = Money{Amount: amount}
+	return nil
+}
+
+// ratFromFloat возвращает точное значение числа f.
+func ratFromFloat(f float64) *big.Rat {
+	r := new(big.Rat)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return r
+	}
+	// десятичное представление числа, чтобы 0.1 оставалось 1/10, а не ближайшим двоичным приближением
+	r.SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	return r
+}
+
+// roundRat округляет число r до целого способом mode.
+//
+// Возвращает [ErrMoneyOverflow], если результат не помещается в int64.
+func roundRat(r *big.Rat, mode RoundingMode) (int64, error) {
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+
+	if remainder.Sign() != 0 {
+		// удвоенный остаток по модулю сравнивается со знаменателем, чтобы определить половину
+		half := new(big.Int).Abs(remainder)
+		half.Lsh(half, 1)
+		cmp := half.Cmp(r.Denom())
+
+		awayFromZero := false
+		switch mode {
+		case RoundHalfUp:
+			awayFromZero = cmp >= 0
+		case RoundHalfEven:
+			awayFromZero = cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1)
+		case RoundUp:
+			awayFromZero = true
+		case RoundDown:
+			awayFromZero = false
+		}
+
+		if awayFromZero {
+			quotient.Add(quotient, big.NewInt(int64(r.Sign())))
+		}
+	}
+
+	if !quotient.IsInt64() {
+		return 0, ErrMoneyOverflow
+	}
+
+	return quotient.Int64(), nil
+}
+
+// moneyFromFloatPtr возвращает указатель на сумму value в копейках или nil, если value равен nil.
+func moneyFromFloatPtr(value *float64) *Money {
+	if value == nil {
+		return nil
+	}
+	return NewMoney(MoneyFromFloat(*value))
+}
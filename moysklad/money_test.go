@@ -0,0 +1,195 @@
+package moysklad_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+)
+
+func TestMoneyRounding(t *testing.T) {
+	tests := []struct {
+		amount int64
+		n      int64
+		mode   moysklad.RoundingMode
+		want   int64
+	}{
+		{5, 2, moysklad.RoundHalfUp, 3},
+		{-5, 2, moysklad.RoundHalfUp, -3},
+		{5, 2, moysklad.RoundHalfEven, 2},
+		{7, 2, moysklad.RoundHalfEven, 4},
+		{-7, 2, moysklad.RoundHalfEven, -4},
+		{5, 2, moysklad.RoundDown, 2},
+		{-5, 2, moysklad.RoundDown, -2},
+		{4, 3, moysklad.RoundUp, 2},
+		{-4, 3, moysklad.RoundUp, -2},
+		{6, 3, moysklad.RoundUp, 2},
+	}
+
+	for _, test := range tests {
+		got, err := moysklad.Kopecks(test.amount).Div(test.n, test.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Amount != test.want {
+			t.Errorf("%d / %d (mode %d): got %d, want %d", test.amount, test.n, test.mode, got.Amount, test.want)
+		}
+	}
+}
+
+func TestMoneyMulFloat(t *testing.T) {
+	tests := []struct {
+		amount int64
+		f      float64
+		mode   moysklad.RoundingMode
+		want   int64
+	}{
+		// 0.1 используется точно, без двоичной погрешности
+		{15, 0.1, moysklad.RoundHalfUp, 2},
+		{15, 0.1, moysklad.RoundHalfEven, 2},
+		{25, 0.1, moysklad.RoundHalfEven, 2},
+		{123450, 3, moysklad.RoundHalfUp, 370350},
+		{100, 1.005, moysklad.RoundHalfUp, 101},
+	}
+
+	for _, test := range tests {
+		got, err := moysklad.Kopecks(test.amount).MulFloat(test.f, test.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Amount != test.want {
+			t.Errorf("%d × %v (mode %d): got %d, want %d", test.amount, test.f, test.mode, got.Amount, test.want)
+		}
+	}
+
+	vat, err := moysklad.Kopecks(370350).Percent(20, moysklad.RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vat.Amount != 74070 {
+		t.Errorf("got vat %d, want 74070", vat.Amount)
+	}
+}
+
+func TestMoneyDivisionByZero(t *testing.T) {
+	if _, err := moysklad.Kopecks(100).Div(0, moysklad.RoundHalfUp); !errors.Is(err, moysklad.ErrMoneyDivisionByZero) {
+		t.Fatalf("got error %v, want ErrMoneyDivisionByZero", err)
+	}
+}
+
+func TestMoneyOverflow(t *testing.T) {
+	maxMoney, minMoney := moysklad.Kopecks(math.MaxInt64), moysklad.Kopecks(math.MinInt64)
+
+	tests := []struct {
+		name string
+		op   func() (moysklad.Money, error)
+	}{
+		{"add", func() (moysklad.Money, error) { return maxMoney.Add(moysklad.Kopecks(1)) }},
+		{"add negative", func() (moysklad.Money, error) { return minMoney.Add(moysklad.Kopecks(-1)) }},
+		{"sub", func() (moysklad.Money, error) { return minMoney.Sub(moysklad.Kopecks(1)) }},
+		{"sub negative", func() (moysklad.Money, error) { return maxMoney.Sub(moysklad.Kopecks(-1)) }},
+		{"mul", func() (moysklad.Money, error) { return maxMoney.Mul(2) }},
+		{"mul min", func() (moysklad.Money, error) { return minMoney.Mul(-1) }},
+		{"mul float", func() (moysklad.Money, error) { return maxMoney.MulFloat(1.5, moysklad.RoundHalfUp) }},
+		{"percent", func() (moysklad.Money, error) { return maxMoney.Percent(200, moysklad.RoundHalfUp) }},
+		{"div min", func() (moysklad.Money, error) { return minMoney.Div(-1, moysklad.RoundHalfUp) }},
+		{"in base", func() (moysklad.Money, error) { return maxMoney.WithCurrency(nil, 2).InBase(moysklad.RoundHalfUp) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.op(); !errors.Is(err, moysklad.ErrMoneyOverflow) {
+				t.Fatalf("got error %v, want ErrMoneyOverflow", err)
+			}
+		})
+	}
+
+	// граничные значения без переполнения
+	if sum, err := maxMoney.Add(moysklad.Kopecks(-1)); err != nil || sum.Amount != math.MaxInt64-1 {
+		t.Fatalf("got %d, %v, want %d", sum.Amount, err, int64(math.MaxInt64-1))
+	}
+	if diff, err := minMoney.Sub(moysklad.Kopecks(-1)); err != nil || diff.Amount != math.MinInt64+1 {
+		t.Fatalf("got %d, %v, want %d", diff.Amount, err, int64(math.MinInt64+1))
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want int64
+	}{
+		{`123450`, 123450},
+		{`-5`, -5},
+		{`"123450"`, 123450},
+		{`null`, 0},
+		{`1234.5`, 1235},
+		{`-1234.5`, -1235},
+		{`1e3`, 1000},
+	}
+
+	for _, test := range tests {
+		var money moysklad.Money
+		if err := json.Unmarshal([]byte(test.data), &money); err != nil {
+			t.Fatalf("%s: %v", test.data, err)
+		}
+		if money.Amount != test.want {
+			t.Errorf("%s: got %d, want %d", test.data, money.Amount, test.want)
+		}
+	}
+
+	// сумма передаётся числом в копейках без потери точности
+	type document struct {
+		Sum *moysklad.Money `json:"sum,omitempty"`
+	}
+
+	for _, amount := range []int64{0, 1, -1, 123456789, math.MaxInt64, math.MinInt64} {
+		data, err := json.Marshal(document{Sum: moysklad.NewMoney(moysklad.Kopecks(amount))})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got document
+		if err = json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Sum == nil || got.Sum.Amount != amount {
+			t.Fatalf("%s: got %v, want %d", data, got.Sum, amount)
+		}
+	}
+
+	var money moysklad.Money
+	if err := json.Unmarshal([]byte(`1e30`), &money); !errors.Is(err, moysklad.ErrMoneyOverflow) {
+		t.Fatalf("got error %v, want ErrMoneyOverflow", err)
+	}
+	if err := json.Unmarshal([]byte(`"abc"`), &money); err == nil {
+		t.Fatal("got no error, want invalid money value")
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int64
+		err   bool
+	}{
+		{"1234.56", 123456, false},
+		{"1 234,56", 123456, false},
+		{"-0.5", -50, false},
+		{"0.001", 0, true},
+		{"abc", 0, true},
+		{"100000000000000000000", 0, true},
+	}
+
+	for _, test := range tests {
+		money, err := moysklad.ParseMoney(test.value)
+		if (err != nil) != test.err || money.Amount != test.want {
+			t.Errorf("ParseMoney(%q): got %d, %v", test.value, money.Amount, err)
+		}
+	}
+
+	if money := moysklad.Kopecks(-123456789); money.String() != "-1234567.89" || money.Format() != "-1\u00a0234\u00a0567,89" {
+		t.Errorf("got %q and %q", money.String(), money.Format())
+	}
+}
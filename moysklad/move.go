@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -43,7 +44,7 @@ type Move struct {
 	Shared        *bool                     `json:"shared,omitempty"`        // Общий доступ
 	SourceStore   *Store                    `json:"sourceStore,omitempty"`   // Метаданные склада, с которого совершается перемещение
 	State         *NullValue[State]         `json:"state,omitempty"`         // Метаданные статуса Перемещения
-	Sum           *float64                  `json:"sum,omitempty"`           // Сумма Перемещения в копейках
+	Sum           *Money                    `json:"sum,omitempty"`           // Сумма Перемещения в копейках
 	SyncID        *string                   `json:"syncId,omitempty"`        // ID синхронизации
 	Supply        *Supply                   `json:"supply,omitempty"`        // Метаданные Приемки, связанной с Перемещением
 	TargetStore   *Store                    `json:"targetStore,omitempty"`   // Метаданные склада, на который совершается перемещение
@@ -204,7 +205,7 @@ func (move Move) GetState() State {
 
 // GetSum возвращает Сумму Перемещения в копейках.
 func (move Move) GetSum() float64 {
-	return Deref(move.Sum)
+	return Deref(move.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -432,9 +433,9 @@ type MovePosition struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Overhead   *float64            `json:"overhead,omitempty"`   // Накладные расходы. Если Позиции Перемещения не заданы, то накладные расходы нельзя задать
+	Overhead   *Money              `json:"overhead,omitempty"`   // Накладные расходы. Если Позиции Перемещения не заданы, то накладные расходы нельзя задать
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе
 	SourceSlot *Slot               `json:"sourceSlot,omitempty"` // Ячейка на складе, с которого совершается перемещение
 	TargetSlot *Slot               `json:"targetSlot,omitempty"` // Ячейка на складе, на который совершается перемещение
@@ -458,7 +459,7 @@ func (movePosition MovePosition) GetID() string {
 
 // GetOverhead возвращает Накладные расходы.
 func (movePosition MovePosition) GetOverhead() float64 {
-	return Deref(movePosition.Overhead)
+	return Deref(movePosition.Overhead).Float()
 }
 
 // GetPack возвращает Упаковку Товара.
@@ -468,7 +469,7 @@ func (movePosition MovePosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (movePosition MovePosition) GetPrice() float64 {
-	return Deref(movePosition.Price)
+	return Deref(movePosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -514,7 +515,7 @@ func (movePosition *MovePosition) SetPack(pack *Pack) *MovePosition {
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (movePosition *MovePosition) SetPrice(price float64) *MovePosition {
-	movePosition.Price = &price
+	movePosition.Price = NewMoney(MoneyFromFloat(price))
 	return movePosition
 }
 
@@ -573,6 +574,21 @@ type MoveService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Move], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех перемещений.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Move, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех перемещений.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Move]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех перемещений асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Move, error]
+
 	// Create выполняет запрос на создание перемещения.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -585,13 +601,13 @@ type MoveService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение перемещений.
 	// Изменяемые перемещения должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список перемещений и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых перемещений.
-	CreateUpdateMany(ctx context.Context, moveList Slice[Move], params ...func(*Params)) (*Slice[Move], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых перемещений и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, moveList Slice[Move], params ...func(*Params)) (*BulkResult[Move], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление перемещений.
 	// Принимает контекст и множество перемещений.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Move) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Move) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление перемещения по ID.
 	// Принимает контекст и ID перемещения.
@@ -638,6 +654,11 @@ type MoveService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[MovePosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*MovePosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -655,8 +676,8 @@ type MoveService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*MovePosition) (*Slice[MovePosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*MovePosition) (*BulkResult[MovePosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -668,6 +689,12 @@ type MoveService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*MovePosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[MovePosition], key func(position *MovePosition) string) (*PositionSyncResult[MovePosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -701,8 +728,8 @@ type MoveService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -716,8 +743,8 @@ type MoveService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -771,8 +798,8 @@ type MoveService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
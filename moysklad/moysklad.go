@@ -3,10 +3,10 @@ package moysklad
 import (
 	"fmt"
 	"github.com/go-resty/resty/v2"
-	"go.uber.org/ratelimit"
+	"io"
+	"log/slog"
 	"net/http"
 	"strconv"
-	"sync"
 	"time"
 )
 
@@ -21,23 +21,30 @@ const (
 	MaxPositions                 = 1000                                     // Максимальное число объектов, передаваемых в одном массиве в запросе
 	MaxQueriesPerSecond          = 15                                       // Не более 45 запросов за 3 секундный период от аккаунта (45/3)
 	MaxQueriesPerUser            = 5                                        // Не более 5 параллельных запросов от одного пользователя
+	MaxAsyncTasks                = 3                                        // Не более 3 одновременно выполняемых асинхронных задач
+	DefaultReservedHighPriority  = 0                                        // Количество мест параллельных запросов, зарезервированных для запросов с высоким приоритетом
 	MaxPrintCount                = 1000                                     // Максимальное количество ценников/термоэтикеток
 	headerRateLimit              = "X-RateLimit-Limit"                      // Количество запросов, которые равномерно можно сделать в течение интервала до появления 429 ошибки.
 	headerRateRemaining          = "X-RateLimit-Remaining"                  // Число запросов, которые можно отправить до получения 429 ошибки.
 	headerRetryTimeInterval      = "X-Lognex-Retry-TimeInterval"            // Интервал в миллисекундах, в течение которого можно сделать эти запросы
+	headerRetryAfter             = "X-Lognex-Retry-After"                   // Время до сброса ограничения в миллисекундах.
 
 	//MaxFiles                = 100                           // Максимальное количество файлов
 	//MaxImages               = 10                            // Максимальное количество изображений
 	//headerRateReset         = "X-Lognex-Reset"              // Время до сброса ограничения в миллисекундах. Равно нулю, если ограничение не установлено.
-	//headerRetryAfter        = "X-Lognex-Retry-After"        // Время до сброса ограничения в миллисекундах.
 )
 
 // Client базовый клиент для взаимодействия с API МойСклад.
 type Client struct {
-	nextReqTime time.Time
 	*resty.Client
-	limits *queryLimits
-	mu     sync.Mutex
+	limits      *queryLimits
+	asyncTasks  asyncQueue
+	retryPolicy *RetryPolicy
+	logger      *slog.Logger
+	middlewares []Middleware
+	metrics     Metrics
+	tokenSource TokenSource
+	location    *time.Location
 }
 
 // Config конфигурация клиента.
@@ -59,6 +66,12 @@ type Config struct {
 	// Токен (в приоритете).
 	Token string
 
+	// Источник токена (в приоритете перед Token, Username и Password).
+	//
+	// При получении ответа 401 токен обновляется один раз и запрос повторяется.
+	// См. [StaticTokenSource], [NewPasswordTokenSource], [NewFileTokenSource], [NewEnvTokenSource].
+	TokenSource TokenSource
+
 	// Логин.
 	Username string
 
@@ -81,17 +94,70 @@ type Config struct {
 	//
 	// [Подробнее]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-vebhuki-primer-webhuka-zagolowok-wremennogo-otklucheniq-cherez-api
 	DisabledWebhookContent bool
+
+	// Политика повторного выполнения запросов при ошибках 429, 5xx и сетевых ошибках.
+	//
+	// Если не указана, запросы не повторяются. См. [DefaultRetryPolicy].
+	RetryPolicy *RetryPolicy
+
+	// Часовой пояс учётной записи.
+	//
+	// Используется при разборе и передаче [Timestamp], в параметрах периода и условиях фильтрации запросов клиента.
+	// Если не указан, используется часовой пояс по умолчанию (см. [Location]).
+	Location *time.Location
+
+	// Базовый адрес API.
+	//
+	// Если не указан, используется адрес API МойСклад. Позволяет направить запросы, например,
+	// на тестовый сервер (см. пакет mstest).
+	BaseURL string
+
+	// Журнал запросов.
+	//
+	// Запросы журналируются с уровнем Debug, повторные попытки – с уровнем Warn.
+	// Если не указан, записи не выводятся.
+	Logger *slog.Logger
+
+	// Цепочка обработчиков каждой попытки запроса (см. [Middleware]).
+	//
+	// Первый элемент вызывается первым.
+	Middlewares []Middleware
+
+	// Максимальное количество параллельных запросов клиента.
+	//
+	// Не может превышать [MaxQueriesPerUser] (значение по умолчанию).
+	MaxConcurrentRequests int
+
+	// Количество мест параллельных запросов, зарезервированных для запросов с приоритетом [PriorityHigh] (см. [WithPriority]).
+	//
+	// По умолчанию [DefaultReservedHighPriority] – места не резервируются.
+	ReservedHighPriority int
+
+	// Максимальное количество параллельных запросов с приоритетом [PriorityBulk] (см. [WithPriority]).
+	//
+	// По умолчанию на одно место меньше, чем доступно запросам с приоритетом [PriorityNormal], но не менее одного.
+	MaxBulkRequests int
+
+	// Минимальный интервал между запросами клиента.
+	//
+	// Позволяет ограничить долю лимита запросов аккаунта, которую расходует клиент.
+	// Если не указан, интервал рассчитывается только по заголовкам ответа.
+	MinRequestInterval time.Duration
+
+	// Сборщик метрик запросов (см. [Metrics], [MetricsCollector]).
+	//
+	// Если не указан, метрики не собираются.
+	Metrics Metrics
 }
 
 // apply применяет конфигурацию к клиенту.
 func (config Config) apply(client *Client) {
-	if config.HTTPClient != nil {
-		client.Client = resty.NewWithClient(config.HTTPClient)
-	}
-
-	if config.RestyClient != nil {
+	switch {
+	case config.RestyClient != nil:
 		client.Client = config.RestyClient
-	} else {
+	case config.HTTPClient != nil:
+		client.Client = resty.NewWithClient(config.HTTPClient)
+	default:
 		client.Client = resty.New()
 	}
 
@@ -113,8 +179,39 @@ func (config Config) apply(client *Client) {
 		}
 	}
 
+	// количество запросов за 3-х секундный период и количество параллельных запросов.
+	concurrency := MaxQueriesPerUser
+	if config.MaxConcurrentRequests > 0 {
+		concurrency = min(config.MaxConcurrentRequests, MaxQueriesPerUser)
+	}
+	reserved := DefaultReservedHighPriority
+	if config.ReservedHighPriority != 0 {
+		reserved = max(config.ReservedHighPriority, 0)
+	}
+	client.limits = newQueryLimits(concurrency, reserved, config.MaxBulkRequests, config.MinRequestInterval)
+
+	client.retryPolicy = config.RetryPolicy
+	client.location = config.Location
+	client.tokenSource = config.TokenSource
+	client.middlewares = config.Middlewares
+
+	client.metrics = config.Metrics
+	if client.metrics == nil {
+		client.metrics = nopMetrics{}
+	}
+	client.metrics.SetMaxInFlight(client.limits.slots.capacity)
+
+	client.logger = config.Logger
+	if client.logger == nil {
+		client.logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+
 	// устанавливаем базовый URL
-	client.SetBaseURL(baseApiURL)
+	if config.BaseURL != "" {
+		client.SetBaseURL(config.BaseURL)
+	} else {
+		client.SetBaseURL(baseApiURL)
+	}
 
 	// устанавливаем необходимые заголовки
 	client.Header.Set("Accept", "application/json;charset=utf-8")
@@ -134,11 +231,8 @@ func (config Config) apply(client *Client) {
 //	})
 func New(config Config) *Client {
 	client := &Client{
-		limits: &queryLimits{
-			// количество запросов за 3-х секундный период и количество параллельных запросов.
-			rl:       ratelimit.New(MaxQueriesPerSecond),
-			queryBuf: make(chan struct{}, MaxQueriesPerUser),
-		},
+		// количество одновременно выполняемых асинхронных задач.
+		asyncTasks: make(asyncQueue, MaxAsyncTasks),
 	}
 
 	config.apply(client)
@@ -146,18 +240,16 @@ func New(config Config) *Client {
 	return client
 }
 
-// queryLimits используется для ограничения количества параллельных запросов
-// и минимального интервала между ними.
-type queryLimits struct {
-	rl       ratelimit.Limiter // Лимитатор для контроля частоты запросов
-	queryBuf chan struct{}     // Буфер для управления параллельными запросами
-}
-
-func (queryLimits *queryLimits) Wait() {
-	queryLimits.queryBuf <- struct{}{}
-	queryLimits.rl.Take()
+// Location возвращает часовой пояс учётной записи клиента (см. Config.Location).
+func (client *Client) Location() *time.Location {
+	if client.location != nil {
+		return client.location
+	}
+	return Location()
 }
 
-func (queryLimits *queryLimits) Done() {
-	<-queryLimits.queryBuf
+// RateLimits возвращает текущий интервал между запросами и оставшееся число запросов,
+// рассчитанные по заголовкам последнего ответа.
+func (client *Client) RateLimits() RateLimits {
+	return client.limits.state()
 }
@@ -0,0 +1,123 @@
+package mstest
+
+import (
+	"github.com/arcsub/go-moysklad/moysklad"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// startTask выполняет запрос с параметром async=true и возвращает ответ 202 со ссылками на статус и результат задачи.
+//
+// Результат запроса сохраняется в задаче и становится доступен через время, заданное [WithAsyncDelay].
+func (server *Server) startTask(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	status, response := server.route(r, path, body)
+
+	if status >= http.StatusBadRequest {
+		writeJSON(w, status, response)
+		return
+	}
+
+	data, err := marshal(response)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, 1000, err.Error())
+		return
+	}
+
+	t := &task{
+		id:      newID(),
+		request: server.URL + r.URL.String(),
+		created: time.Now(),
+		status:  status,
+		body:    data,
+	}
+	server.tasks[t.id] = t
+	server.taskIDs = append(server.taskIDs, t.id)
+
+	w.Header().Set("Location", server.taskURL(t))
+	w.Header().Set("Content-Location", server.taskURL(t)+"/result")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// routeAsync выполняет запрос к асинхронным задачам.
+func (server *Server) routeAsync(r *http.Request, parts []string) (int, any) {
+	if len(parts) == 0 || parts[0] == "" {
+		if r.Method != http.MethodGet {
+			return apiError(http.StatusMethodNotAllowed, 1002, "Метод не поддерживается: async")
+		}
+
+		rows := make([]any, 0, len(server.taskIDs))
+		for _, id := range server.taskIDs {
+			rows = append(rows, server.taskStatus(server.tasks[id]))
+		}
+		return http.StatusOK, map[string]any{
+			"meta": map[string]any{"href": server.BaseURL() + "async", "type": "async", "size": len(rows), "limit": 1000, "offset": 0},
+			"rows": rows,
+		}
+	}
+
+	t, ok := server.tasks[parts[0]]
+	if !ok {
+		return notFound(string(moysklad.MetaTypeAsync), parts[0])
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		return http.StatusOK, server.taskStatus(t)
+	case len(parts) == 2 && parts[1] == "result" && r.Method == http.MethodGet:
+		if state := server.taskState(t); state != moysklad.AsyncStateDone {
+			return apiError(http.StatusBadRequest, 1000, "Результат задачи недоступен: "+string(state))
+		}
+		if t.body == nil {
+			return t.status, nil
+		}
+		return t.status, rawJSON(t.body)
+	case len(parts) == 2 && parts[1] == "cancel" && (r.Method == http.MethodPut || r.Method == http.MethodPost):
+		if server.taskState(t) == moysklad.AsyncStateDone {
+			return apiError(http.StatusBadRequest, 1000, "Задача уже выполнена")
+		}
+		t.cancel = true
+		return http.StatusNoContent, nil
+	default:
+		return apiError(http.StatusMethodNotAllowed, 1002, "Метод не поддерживается: async/"+strings.Join(parts, "/"))
+	}
+}
+
+// taskURL возвращает ссылку на статус задачи.
+func (server *Server) taskURL(t *task) string {
+	return server.BaseURL() + "async/" + t.id
+}
+
+// taskState возвращает статус выполнения задачи.
+func (server *Server) taskState(t *task) moysklad.AsyncState {
+	switch {
+	case t.cancel:
+		return moysklad.AsyncStateCancel
+	case time.Since(t.created) < server.asyncDelay:
+		return moysklad.AsyncStateProcessing
+	default:
+		return moysklad.AsyncStateDone
+	}
+}
+
+// taskStatus возвращает представление задачи в формате API.
+func (server *Server) taskStatus(t *task) map[string]any {
+	status := map[string]any{
+		"meta": map[string]any{
+			"href":      server.taskURL(t),
+			"type":      string(moysklad.MetaTypeAsync),
+			"mediaType": moysklad.ApplicationJson,
+		},
+		"id":        t.id,
+		"accountId": AccountID,
+		"request":   t.request,
+		"state":     server.taskState(t),
+	}
+
+	if server.taskState(t) == moysklad.AsyncStateDone {
+		status["resultUrl"] = server.taskURL(t) + "/result"
+		status["deletionDate"] = moysklad.FormatTime(t.created.Add(7 * 24 * time.Hour))
+	}
+
+	return status
+}
@@ -0,0 +1,156 @@
+package mstest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// rawJSON тело ответа, которое передаётся без изменений.
+type rawJSON []byte
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (raw rawJSON) MarshalJSON() ([]byte, error) {
+	return raw, nil
+}
+
+// readBody читает тело запроса.
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	defer r.Body.Close()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bytes.TrimSpace(body)) > 0 && !json.Valid(body) {
+		return nil, errors.New("invalid json")
+	}
+	return body, nil
+}
+
+// unmarshal разбирает JSON, сохраняя числа без потери точности.
+func unmarshal(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// marshal возвращает представление значения в формате JSON.
+func marshal(v any) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// errNotObject возвращается, если тело запроса или элемент массива не является объектом.
+var errNotObject = errors.New("expected object")
+
+// parseObject разбирает объект из тела запроса.
+func parseObject(body []byte) (map[string]any, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return make(map[string]any), nil
+	}
+
+	var object map[string]any
+	if err := unmarshal(body, &object); err != nil {
+		return nil, err
+	}
+	if object == nil {
+		return nil, errNotObject
+	}
+	return object, nil
+}
+
+// parseObjects разбирает массив объектов из тела запроса.
+func parseObjects(body []byte) ([]map[string]any, error) {
+	var objects []map[string]any
+	if err := unmarshal(body, &objects); err != nil {
+		return nil, err
+	}
+	if objects == nil {
+		return nil, errNotObject
+	}
+	for _, object := range objects {
+		if object == nil {
+			return nil, errNotObject
+		}
+	}
+	return objects, nil
+}
+
+// toObject преобразует объект в представление JSON.
+func toObject(entity any) (map[string]any, error) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	return parseObject(data)
+}
+
+// fromObject преобразует представление JSON в объект entity.
+func fromObject(object map[string]any, entity any) error {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, entity)
+}
+
+// copyObject возвращает глубокую копию объекта.
+func copyObject(object map[string]any) map[string]any {
+	result := make(map[string]any, len(object))
+	for field, value := range object {
+		result[field] = copyValue(value)
+	}
+	return result
+}
+
+// copyValue возвращает глубокую копию значения.
+func copyValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		return copyObject(value)
+	case []any:
+		result := make([]any, len(value))
+		for i, item := range value {
+			result[i] = copyValue(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// hrefOf возвращает ссылку из метаданных объекта.
+func hrefOf(object map[string]any) string {
+	meta, _ := object["meta"].(map[string]any)
+	href, _ := meta["href"].(string)
+	return href
+}
+
+// parseHref возвращает путь коллекции (код сущности или путь к позициям документа) и ID объекта из ссылки на объект.
+func parseHref(href string) (path, id string) {
+	i := strings.LastIndex(href, "/entity/")
+	if i < 0 {
+		return "", ""
+	}
+
+	path, _, _ = strings.Cut(href[i+len("/entity/"):], "?")
+	parts := strings.Split(path, "/")
+	switch {
+	case len(parts) >= 4 && parts[2] == "positions":
+		return positionsPath(parts[0], parts[1]), parts[3]
+	case len(parts) >= 2:
+		return parts[0], parts[1]
+	default:
+		return "", ""
+	}
+}
@@ -0,0 +1,297 @@
+package mstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/arcsub/go-moysklad/moysklad"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// searchFields поля, по которым выполняется контекстный поиск.
+var searchFields = []string{"name", "code", "externalCode", "article", "description", "email", "phone", "inn"}
+
+// operators операторы фильтрации. Двухсимвольные операторы проверяются первыми.
+var operators = []moysklad.FilterType{
+	moysklad.FilterNotEquals,
+	moysklad.FilterGreaterOrEquals,
+	moysklad.FilterLesserOrEquals,
+	moysklad.FilterNotEquivalence,
+	moysklad.FilterEquivalenceLeft,
+	moysklad.FilterEquivalenceRight,
+	moysklad.FilterEquals,
+	moysklad.FilterGreater,
+	moysklad.FilterLesser,
+	moysklad.FilterEquivalence,
+}
+
+// condition условие фильтрации.
+type condition struct {
+	key   string
+	op    moysklad.FilterType
+	value string
+}
+
+// order условие сортировки.
+type order struct {
+	field string
+	desc  bool
+}
+
+// query параметры запроса списка.
+type query struct {
+	conditions []condition
+	orders     []order
+	search     string
+	limit      int
+	offset     int
+}
+
+// parseQuery разбирает параметры запроса списка.
+func parseQuery(values url.Values) (*query, error) {
+	q := &query{limit: moysklad.MaxPositions, search: strings.ToLower(values.Get("search"))}
+
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 || n > moysklad.MaxPositions {
+			return nil, fmt.Errorf("Неверное значение параметра 'limit': %s", limit)
+		}
+		q.limit = n
+	}
+
+	if offset := values.Get("offset"); offset != "" {
+		n, err := strconv.Atoi(offset)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("Неверное значение параметра 'offset': %s", offset)
+		}
+		q.offset = n
+	}
+
+	for _, filter := range splitEscaped(values.Get("filter")) {
+		c, err := parseCondition(filter)
+		if err != nil {
+			return nil, err
+		}
+		q.conditions = append(q.conditions, c)
+	}
+
+	for _, field := range strings.Split(values.Get("order"), ";") {
+		if field == "" {
+			continue
+		}
+		name, direction, _ := strings.Cut(field, ",")
+		q.orders = append(q.orders, order{field: name, desc: direction == string(moysklad.OrderDirectionDesc)})
+	}
+
+	return q, nil
+}
+
+// splitEscaped разбивает значение параметра filter на условия по неэкранированному символу ";".
+func splitEscaped(filter string) []string {
+	var (
+		conditions []string
+		current    strings.Builder
+	)
+
+	for i := 0; i < len(filter); i++ {
+		switch {
+		case filter[i] == '\\' && i+1 < len(filter) && filter[i+1] == ';':
+			current.WriteByte(';')
+			i++
+		case filter[i] == ';':
+			conditions = append(conditions, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(filter[i])
+		}
+	}
+
+	if current.Len() > 0 {
+		conditions = append(conditions, current.String())
+	}
+
+	return conditions
+}
+
+// parseCondition разбирает условие фильтрации.
+func parseCondition(filter string) (condition, error) {
+	for i := 0; i < len(filter); i++ {
+		for _, op := range operators {
+			if strings.HasPrefix(filter[i:], string(op)) && i > 0 {
+				return condition{key: filter[:i], op: op, value: filter[i+len(op):]}, nil
+			}
+		}
+	}
+	return condition{}, fmt.Errorf("Неверное условие фильтрации: %s", filter)
+}
+
+// match возвращает true, если объект удовлетворяет условиям фильтрации и контекстному поиску.
+//
+// Условия "=" с одинаковым ключом объединяются по ИЛИ, остальные условия – по И.
+func (q *query) match(object map[string]any) bool {
+	equals := make(map[string]bool)
+
+	for _, c := range q.conditions {
+		ok := c.match(object)
+		if c.op == moysklad.FilterEquals {
+			equals[c.key] = equals[c.key] || ok
+			continue
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	for _, ok := range equals {
+		if !ok {
+			return false
+		}
+	}
+
+	if q.search == "" {
+		return true
+	}
+
+	for _, field := range searchFields {
+		if value, ok := object[field].(string); ok && strings.Contains(strings.ToLower(value), q.search) {
+			return true
+		}
+	}
+	return false
+}
+
+// match возвращает true, если объект удовлетворяет условию.
+func (c condition) match(object map[string]any) bool {
+	actual, ok := scalar(lookup(object, c.key))
+
+	if c.value == "" {
+		switch c.op {
+		case moysklad.FilterEquals:
+			return !ok || actual == ""
+		case moysklad.FilterNotEquals:
+			return ok && actual != ""
+		}
+	}
+
+	if !ok {
+		return c.op == moysklad.FilterNotEquals || c.op == moysklad.FilterNotEquivalence
+	}
+
+	lower, value := strings.ToLower(actual), strings.ToLower(c.value)
+
+	switch c.op {
+	case moysklad.FilterEquals:
+		return compare(actual, c.value) == 0
+	case moysklad.FilterNotEquals:
+		return compare(actual, c.value) != 0
+	case moysklad.FilterGreater:
+		return compare(actual, c.value) > 0
+	case moysklad.FilterGreaterOrEquals:
+		return compare(actual, c.value) >= 0
+	case moysklad.FilterLesser:
+		return compare(actual, c.value) < 0
+	case moysklad.FilterLesserOrEquals:
+		return compare(actual, c.value) <= 0
+	case moysklad.FilterEquivalence:
+		return strings.Contains(lower, value)
+	case moysklad.FilterNotEquivalence:
+		return !strings.Contains(lower, value)
+	case moysklad.FilterEquivalenceLeft:
+		return strings.HasPrefix(lower, value)
+	case moysklad.FilterEquivalenceRight:
+		return strings.HasSuffix(lower, value)
+	default:
+		return false
+	}
+}
+
+// sort сортирует объекты по условиям сортировки.
+func (q *query) sort(rows []map[string]any) {
+	if len(q.orders) == 0 {
+		return
+	}
+
+	slices.SortStableFunc(rows, func(a, b map[string]any) int {
+		for _, o := range q.orders {
+			l, lok := scalar(lookup(a, o.field))
+			r, rok := scalar(lookup(b, o.field))
+
+			var cmp int
+			switch {
+			case !lok && !rok:
+				cmp = 0
+			case !lok:
+				cmp = -1
+			case !rok:
+				cmp = 1
+			default:
+				cmp = compare(l, r)
+			}
+
+			if o.desc {
+				cmp = -cmp
+			}
+			if cmp != 0 {
+				return cmp
+			}
+		}
+		return 0
+	})
+}
+
+// lookup возвращает значение поля key объекта.
+//
+// Ключ, являющийся ссылкой на доп. поле, возвращает значение доп. поля.
+func lookup(object map[string]any, key string) any {
+	if !strings.HasPrefix(key, "http") {
+		return object[key]
+	}
+
+	attributes, _ := object["attributes"].([]any)
+	for _, attribute := range attributes {
+		if attribute, ok := attribute.(map[string]any); ok && hrefOf(attribute) == key {
+			return attribute["value"]
+		}
+	}
+	return nil
+}
+
+// scalar возвращает значение в виде строки. Для объектов с метаданными возвращается ссылка на объект.
+func scalar(value any) (string, bool) {
+	switch value := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return value, true
+	case json.Number:
+		return value.String(), true
+	case bool:
+		return strconv.FormatBool(value), true
+	case map[string]any:
+		if href := hrefOf(value); href != "" {
+			return href, true
+		}
+		return "", false
+	default:
+		return fmt.Sprint(value), true
+	}
+}
+
+// compare сравнивает значения как числа, если оба значения являются числами, иначе как строки.
+func compare(l, r string) int {
+	lf, lerr := strconv.ParseFloat(l, 64)
+	rf, rerr := strconv.ParseFloat(r, 64)
+	if lerr == nil && rerr == nil {
+		switch {
+		case lf < rf:
+			return -1
+		case lf > rf:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(l, r)
+}
@@ -0,0 +1,439 @@
+package mstest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Redacted значение, которым заменяются скрытые данные.
+const Redacted = "REDACTED"
+
+// DefaultRedactHeaders заголовки, значения которых скрываются при записи.
+var DefaultRedactHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// DefaultRedactFields поля JSON с персональными данными, значения которых скрываются при записи.
+var DefaultRedactFields = []string{
+	"email", "phone", "fax", "inn", "kpp", "ogrn", "ogrnip", "okpo",
+	"legalAddress", "actualAddress", "legalFirstName", "legalMiddleName", "legalLastName",
+	"firstName", "middleName", "lastName", "fullName", "shortFio", "birthDate", "discountCardNumber",
+}
+
+// ErrUnmatched возвращается в режиме воспроизведения, если для запроса нет записанного ответа.
+var ErrUnmatched = errors.New("mstest: unmatched request")
+
+// Mode режим работы [Recorder].
+//
+// Возможные значения:
+//   - ModeReplay – ответы воспроизводятся из файла записи, запросы не отправляются
+//   - ModeRecord – запросы отправляются, запросы и ответы записываются в файл
+type Mode int
+
+const (
+	ModeReplay Mode = iota // Воспроизведение
+	ModeRecord             // Запись
+)
+
+// RecorderOption функция, изменяющая параметры [Recorder].
+type RecorderOption func(*Recorder)
+
+// WithTransport устанавливает транспорт, через который отправляются запросы в режиме записи.
+//
+// По умолчанию используется [http.DefaultTransport].
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(recorder *Recorder) {
+		recorder.transport = transport
+	}
+}
+
+// WithRedactHeaders добавляет заголовки, значения которых скрываются при записи.
+func WithRedactHeaders(headers ...string) RecorderOption {
+	return func(recorder *Recorder) {
+		recorder.redactHeaders = append(recorder.redactHeaders, headers...)
+	}
+}
+
+// WithRedactFields добавляет поля JSON, значения которых скрываются при записи.
+func WithRedactFields(fields ...string) RecorderOption {
+	return func(recorder *Recorder) {
+		for _, field := range fields {
+			recorder.redactFields[field] = struct{}{}
+		}
+	}
+}
+
+// Body тело записанного запроса или ответа.
+//
+// JSON сохраняется как есть, остальной текст – строкой, двоичные данные – в base64.
+type Body struct {
+	JSON   json.RawMessage `json:"json,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Binary []byte          `json:"binary,omitempty"`
+}
+
+// Bytes возвращает тело в исходном виде.
+func (body Body) Bytes() []byte {
+	switch {
+	case len(body.JSON) > 0:
+		return body.JSON
+	case body.Text != "":
+		return []byte(body.Text)
+	default:
+		return body.Binary
+	}
+}
+
+// newBody возвращает [Body] для данных data.
+func newBody(data []byte) Body {
+	switch {
+	case len(data) == 0:
+		return Body{}
+	case json.Valid(data):
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err == nil {
+			return Body{JSON: buf.Bytes()}
+		}
+		return Body{JSON: data}
+	case utf8.Valid(data):
+		return Body{Text: string(data)}
+	default:
+		return Body{Binary: data}
+	}
+}
+
+// Interaction записанный запрос и ответ на него.
+type Interaction struct {
+	Request struct {
+		Method string      `json:"method"`
+		URL    string      `json:"url"`
+		Header http.Header `json:"header,omitempty"`
+		Body   Body        `json:"body"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"statusCode"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       Body        `json:"body"`
+	} `json:"response"`
+}
+
+// key возвращает ключ сопоставления запроса: метод, путь и нормализованные параметры запроса.
+func (interaction *Interaction) key() string {
+	u, err := url.Parse(interaction.Request.URL)
+	if err != nil {
+		return interaction.Request.Method + " " + interaction.Request.URL
+	}
+	return requestKey(interaction.Request.Method, u)
+}
+
+// cassette файл записи.
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder реализует интерфейс [http.RoundTripper], записывающий обмен с API в файл и воспроизводящий его.
+//
+// В режиме записи запросы отправляются через транспорт (см. [WithTransport]), а запросы и ответы
+// сохраняются в файл при вызове Close. Заголовки [DefaultRedactHeaders] и поля JSON [DefaultRedactFields]
+// заменяются значением [Redacted]. Тела ответов сохраняются распакованными.
+//
+// В режиме воспроизведения запросы сопоставляются с записанными по методу, пути и нормализованным параметрам запроса.
+// Адрес сервера не учитывается, поэтому ссылки заголовков Location и Content-Location асинхронных задач
+// и абсолютные ссылки из ответов воспроизводятся без изменений. Одинаковые запросы получают записанные ответы по порядку.
+// После исчерпания записанных ответов на запрос GET повторяется последний ответ (например, при проверке статуса
+// асинхронной задачи), остальные запросы завершаются ошибкой [ErrUnmatched].
+// Запрос без записанного ответа завершается ошибкой [ErrUnmatched], которая также возвращается методом Close.
+//
+// # Пример:
+//
+//	recorder, err := mstest.NewRecorder("testdata/products.json", mstest.ModeReplay)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer func() {
+//		if err := recorder.Close(); err != nil {
+//			t.Error(err)
+//		}
+//	}()
+//
+//	client := moysklad.New(moysklad.Config{Token: os.Getenv("MOYSKLAD_TOKEN"), HTTPClient: recorder.Client()})
+type Recorder struct {
+	mode          Mode
+	path          string
+	transport     http.RoundTripper
+	redactHeaders []string
+	redactFields  map[string]struct{}
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         map[*Interaction]bool
+	last         map[string]*Interaction
+	errs         []error
+}
+
+// NewRecorder создаёт [Recorder] с файлом записи path в режиме mode.
+//
+// В режиме воспроизведения файл записи читается сразу и должен существовать.
+func NewRecorder(path string, mode Mode, options ...RecorderOption) (*Recorder, error) {
+	recorder := &Recorder{
+		mode:          mode,
+		path:          path,
+		transport:     http.DefaultTransport,
+		redactHeaders: append([]string(nil), DefaultRedactHeaders...),
+		redactFields:  make(map[string]struct{}, len(DefaultRedactFields)),
+		used:          make(map[*Interaction]bool),
+		last:          make(map[string]*Interaction),
+	}
+
+	for _, field := range DefaultRedactFields {
+		recorder.redactFields[field] = struct{}{}
+	}
+
+	for _, option := range options {
+		option(recorder)
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("mstest: read cassette: %w", err)
+		}
+
+		var c cassette
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, fmt.Errorf("mstest: parse cassette %s: %w", path, err)
+		}
+		recorder.interactions = c.Interactions
+	}
+
+	return recorder, nil
+}
+
+// Client возвращает [http.Client], запросы которого выполняются через [Recorder].
+//
+// Передаётся в параметре HTTPClient конфигурации клиента.
+func (recorder *Recorder) Client() *http.Client {
+	return &http.Client{Transport: recorder}
+}
+
+// RoundTrip реализует интерфейс [http.RoundTripper].
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if recorder.mode == ModeRecord {
+		return recorder.record(req)
+	}
+	return recorder.replay(req)
+}
+
+// Close завершает работу [Recorder].
+//
+// В режиме записи сохраняет файл записи. Возвращает ошибки несопоставленных запросов.
+func (recorder *Recorder) Close() error {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	errs := recorder.errs
+
+	if recorder.mode == ModeRecord {
+		if err := recorder.save(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// record отправляет запрос и записывает запрос и ответ.
+func (recorder *Recorder) record(req *http.Request) (*http.Response, error) {
+	requestBody, err := readAndRestore(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := recorder.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := decodeBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := new(Interaction)
+	interaction.Request.Method = req.Method
+	interaction.Request.URL = req.URL.String()
+	interaction.Request.Header = recorder.redactHeader(req.Header)
+	interaction.Request.Body = newBody(recorder.redactBody(requestBody))
+	interaction.Response.StatusCode = resp.StatusCode
+	interaction.Response.Header = recorder.redactHeader(resp.Header)
+	interaction.Response.Body = newBody(recorder.redactBody(responseBody))
+
+	recorder.mu.Lock()
+	recorder.interactions = append(recorder.interactions, interaction)
+	recorder.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	resp.ContentLength = int64(len(responseBody))
+	return resp, nil
+}
+
+// replay возвращает записанный ответ на запрос.
+func (recorder *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	key := requestKey(req.Method, req.URL)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	// повторно воспроизводятся только ответы на GET, чтобы лишний изменяющий запрос не остался незамеченным
+	var interaction *Interaction
+	if req.Method == http.MethodGet {
+		interaction = recorder.last[key]
+	}
+
+	for _, candidate := range recorder.interactions {
+		if !recorder.used[candidate] && candidate.key() == key {
+			interaction = candidate
+			recorder.used[candidate] = true
+			recorder.last[key] = candidate
+			break
+		}
+	}
+
+	if interaction == nil {
+		err := fmt.Errorf("%w: %s", ErrUnmatched, key)
+		recorder.errs = append(recorder.errs, err)
+		return nil, err
+	}
+
+	body := interaction.Response.Body.Bytes()
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// save сохраняет записанные запросы и ответы в файл записи.
+func (recorder *Recorder) save() error {
+	data, err := json.MarshalIndent(cassette{Interactions: recorder.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(recorder.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(recorder.path, data, 0o644)
+}
+
+// redactHeader возвращает копию заголовков со скрытыми значениями.
+func (recorder *Recorder) redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range recorder.redactHeaders {
+		if _, ok := header[http.CanonicalHeaderKey(name)]; ok {
+			header.Set(name, Redacted)
+		}
+	}
+	return header
+}
+
+// redactBody возвращает тело JSON со скрытыми значениями полей с персональными данными.
+func (recorder *Recorder) redactBody(data []byte) []byte {
+	var value any
+	if len(data) == 0 || unmarshal(data, &value) != nil {
+		return data
+	}
+
+	redacted, err := json.Marshal(recorder.redactValue(value))
+	if err != nil {
+		return data
+	}
+	return redacted
+}
+
+// redactValue скрывает значения полей с персональными данными.
+func (recorder *Recorder) redactValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for field, fieldValue := range value {
+			if _, ok := recorder.redactFields[field]; ok && fieldValue != nil {
+				value[field] = Redacted
+				continue
+			}
+			value[field] = recorder.redactValue(fieldValue)
+		}
+		return value
+	case []any:
+		for i, item := range value {
+			value[i] = recorder.redactValue(item)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// requestKey возвращает ключ сопоставления запроса: метод, путь и параметры запроса, отсортированные по имени.
+func requestKey(method string, u *url.URL) string {
+	key := method + " " + u.EscapedPath()
+	if query := u.Query().Encode(); query != "" {
+		key += "?" + query
+	}
+	return key
+}
+
+// readAndRestore читает тело запроса и восстанавливает его для последующего чтения.
+func readAndRestore(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// decodeBody читает тело ответа, распаковывая gzip. Удаляет заголовки сжатия.
+func decodeBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+
+	var reader io.Reader = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+
+		reader = gzipReader
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.Uncompressed = true
+	}
+
+	return io.ReadAll(reader)
+}
@@ -0,0 +1,62 @@
+package mstest_test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad/mstest"
+)
+
+const testCassette = `{"interactions":[
+	{"request":{"method":"GET","url":"https://api.moysklad.ru/api/remap/1.2/async/1","body":{}},
+	 "response":{"statusCode":200,"body":{"json":{"state":"PROCESSING"}}}},
+	{"request":{"method":"POST","url":"https://api.moysklad.ru/api/remap/1.2/entity/product","body":{}},
+	 "response":{"statusCode":200,"body":{"json":{"name":"Товар"}}}}
+]}`
+
+func TestRecorderReplayRepeatsOnlyGet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := os.WriteFile(path, []byte(testCassette), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	recorder, err := mstest.NewRecorder(path, mstest.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := recorder.Client()
+
+	do := func(method, url string) error {
+		req, err := http.NewRequest(method, url, strings.NewReader("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	// ответ на GET повторяется после исчерпания записанных
+	for range 3 {
+		if err = do(http.MethodGet, "http://localhost/api/remap/1.2/async/1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = do(http.MethodPost, "http://localhost/api/remap/1.2/entity/product"); err != nil {
+		t.Fatal(err)
+	}
+	if err = do(http.MethodPost, "http://localhost/api/remap/1.2/entity/product"); !errors.Is(err, mstest.ErrUnmatched) {
+		t.Fatalf("got error %v, want ErrUnmatched", err)
+	}
+
+	if err = recorder.Close(); !errors.Is(err, mstest.ErrUnmatched) {
+		t.Fatalf("got close error %v, want ErrUnmatched", err)
+	}
+}
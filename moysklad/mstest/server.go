@@ -0,0 +1,675 @@
+// Package mstest содержит сервер, имитирующий JSON API 1.2 МойСклад, для интеграционных тестов.
+//
+// Сервер хранит объекты в памяти и поддерживает создание, получение, изменение и удаление объектов любых сущностей
+// (entity/...), постраничное получение списков (limit, offset), фильтрацию (filter), контекстный поиск (search),
+// сортировку (order), замену ссылок объектами (expand), массовое создание, изменение и удаление объектов,
+// позиции документов (entity/{type}/{id}/positions), асинхронные задачи (async=true) и ограничение на количество запросов с ответом 429.
+//
+// # Пример:
+//
+//	server := mstest.NewServer()
+//	defer server.Close()
+//
+//	client := server.Client(moysklad.Config{Token: "test"})
+//
+//	product, _, err := client.Entity().Product().Create(ctx, &moysklad.Product{Name: moysklad.String("Товар")})
+package mstest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/arcsub/go-moysklad/moysklad"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Path путь к API на сервере.
+	Path = "/api/remap/1.2/"
+
+	// AccountID ID учётной записи, который устанавливается создаваемым объектам.
+	AccountID = "00000000-0000-0000-0000-000000000001"
+
+	// DefaultRateLimit количество запросов за период [DefaultRateInterval], как в API МойСклад.
+	DefaultRateLimit = 45
+
+	// DefaultRateInterval период ограничения на количество запросов.
+	DefaultRateInterval = 3 * time.Second
+)
+
+// Option функция, изменяющая параметры сервера.
+type Option func(*Server)
+
+// WithRateLimit устанавливает ограничение на количество запросов limit за период interval.
+//
+// При превышении ограничения сервер отвечает 429. Значение limit = 0 отключает ограничение.
+func WithRateLimit(limit int, interval time.Duration) Option {
+	return func(server *Server) {
+		server.rateLimit = limit
+		server.rateInterval = interval
+	}
+}
+
+// WithAsyncDelay устанавливает время выполнения асинхронных задач.
+//
+// По умолчанию задачи выполняются сразу.
+func WithAsyncDelay(delay time.Duration) Option {
+	return func(server *Server) {
+		server.asyncDelay = delay
+	}
+}
+
+// Server сервер, имитирующий JSON API 1.2 МойСклад.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	collections map[string]*collection // объекты по коду сущности
+	tasks       map[string]*task       // асинхронные задачи по ID
+	taskIDs     []string               // ID асинхронных задач в порядке создания
+	requests    []time.Time            // время запросов за период ограничения
+	throttle    int                    // количество запросов, на которые будет возвращён ответ 429
+	total       int                    // общее количество запросов
+
+	rateLimit    int
+	rateInterval time.Duration
+	asyncDelay   time.Duration
+}
+
+// collection объекты одной сущности в порядке создания.
+type collection struct {
+	ids  []string
+	rows map[string]map[string]any
+}
+
+// task асинхронная задача.
+type task struct {
+	id      string
+	request string
+	created time.Time
+	status  int
+	body    []byte
+	cancel  bool
+}
+
+// NewServer создаёт и запускает сервер.
+//
+// Сервер должен быть остановлен вызовом метода Close.
+func NewServer(options ...Option) *Server {
+	server := &Server{
+		collections:  make(map[string]*collection),
+		tasks:        make(map[string]*task),
+		rateLimit:    DefaultRateLimit,
+		rateInterval: DefaultRateInterval,
+	}
+
+	for _, option := range options {
+		option(server)
+	}
+
+	server.Server = httptest.NewServer(server)
+	return server
+}
+
+// BaseURL возвращает базовый адрес API сервера.
+func (server *Server) BaseURL() string {
+	return server.URL + Path
+}
+
+// Client возвращает клиент, запросы которого направляются на сервер.
+func (server *Server) Client(config moysklad.Config) *moysklad.Client {
+	config.BaseURL = server.BaseURL()
+	return moysklad.New(config)
+}
+
+// Seed добавляет объекты entities на сервер.
+//
+// Код сущности определяется по типу объекта (см. [moysklad.MetaTypeFromEntity]).
+// Объектам без ID устанавливаются ID и метаданные.
+func (server *Server) Seed(entities ...any) error {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	for _, entity := range entities {
+		metaType := moysklad.MetaTypeFromEntity(entity)
+		if metaType == moysklad.MetaTypeUnknown {
+			return fmt.Errorf("mstest: unknown entity type %T", entity)
+		}
+
+		object, err := toObject(entity)
+		if err != nil {
+			return err
+		}
+
+		server.create(string(metaType), object)
+	}
+
+	return nil
+}
+
+// Get возвращает объект типа T с ID id.
+func Get[T any](server *Server, id string) (*T, bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	collection, ok := server.collections[string(moysklad.MetaTypeFromEntity(new(T)))]
+	if !ok {
+		return nil, false
+	}
+
+	object, ok := collection.rows[id]
+	if !ok {
+		return nil, false
+	}
+
+	entity := new(T)
+	if err := fromObject(object, entity); err != nil {
+		return nil, false
+	}
+
+	return entity, true
+}
+
+// Len возвращает количество объектов сущности metaType.
+func (server *Server) Len(metaType moysklad.MetaType) int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if collection, ok := server.collections[string(metaType)]; ok {
+		return len(collection.ids)
+	}
+	return 0
+}
+
+// Throttle устанавливает количество следующих запросов n, на которые сервер ответит 429.
+func (server *Server) Throttle(n int) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.throttle = n
+}
+
+// Requests возвращает общее количество запросов к серверу.
+func (server *Server) Requests() int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	return server.total
+}
+
+// ServeHTTP реализует интерфейс [http.Handler].
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.total++
+
+	if !server.allow(w) {
+		return
+	}
+
+	path, ok := strings.CutPrefix(r.URL.Path, Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, 1005, "Неизвестный адрес: "+r.URL.Path)
+		return
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, 2016, "Ошибка формата JSON: "+err.Error())
+		return
+	}
+
+	if r.URL.Query().Get("async") == "true" {
+		server.startTask(w, r, path, body)
+		return
+	}
+
+	status, response := server.route(r, path, body)
+	writeJSON(w, status, response)
+}
+
+// allow проверяет ограничение на количество запросов и устанавливает заголовки ограничения.
+func (server *Server) allow(w http.ResponseWriter) bool {
+	if server.throttle > 0 {
+		server.throttle--
+		w.Header().Set("X-Lognex-Retry-After", "1")
+		writeError(w, http.StatusTooManyRequests, moysklad.ApiErrorCodeRateLimit, "Превышено ограничение на количество запросов")
+		return false
+	}
+
+	if server.rateLimit <= 0 {
+		return true
+	}
+
+	now := time.Now()
+
+	// удаляем запросы, вышедшие за период ограничения
+	i := 0
+	for i < len(server.requests) && now.Sub(server.requests[i]) >= server.rateInterval {
+		i++
+	}
+	server.requests = server.requests[i:]
+
+	header := w.Header()
+	header.Set("X-RateLimit-Limit", fmt.Sprint(server.rateLimit))
+	header.Set("X-Lognex-Retry-TimeInterval", fmt.Sprint(server.rateInterval.Milliseconds()))
+
+	if len(server.requests) >= server.rateLimit {
+		retryAfter := server.rateInterval - now.Sub(server.requests[0])
+		header.Set("X-RateLimit-Remaining", "0")
+		header.Set("X-Lognex-Retry-After", fmt.Sprint(max(retryAfter.Milliseconds(), 1)))
+		writeError(w, http.StatusTooManyRequests, moysklad.ApiErrorCodeRateLimit, "Превышено ограничение на количество запросов")
+		return false
+	}
+
+	server.requests = append(server.requests, now)
+	header.Set("X-RateLimit-Remaining", fmt.Sprint(server.rateLimit-len(server.requests)))
+	return true
+}
+
+// route выполняет запрос и возвращает HTTP статус и тело ответа.
+func (server *Server) route(r *http.Request, path string, body []byte) (int, any) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case parts[0] == "entity" && len(parts) >= 2:
+		return server.routeEntity(r, parts[1:], body)
+	case parts[0] == string(moysklad.MetaTypeAsync):
+		return server.routeAsync(r, parts[1:])
+	default:
+		return apiError(http.StatusNotFound, 1005, "Неизвестный адрес: "+path)
+	}
+}
+
+// routeEntity выполняет запрос к объектам сущности или к позициям документа.
+func (server *Server) routeEntity(r *http.Request, parts []string, body []byte) (int, any) {
+	metaType := parts[0]
+
+	if len(parts) >= 3 && parts[2] == "positions" {
+		if _, ok := server.find(metaType, parts[1]); !ok {
+			return notFound(metaType, parts[1])
+		}
+		return server.routeCollection(r, positionsPath(metaType, parts[1]), parts[3:], body)
+	}
+
+	return server.routeCollection(r, metaType, parts[1:], body)
+}
+
+// routeCollection выполняет запрос к коллекции объектов path (код сущности или путь к позициям документа).
+//
+// Элементы parts – путь запроса после пути коллекции.
+func (server *Server) routeCollection(r *http.Request, path string, parts []string, body []byte) (int, any) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		return server.list(r, path)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+			return server.bulkCreateUpdate(path, body)
+		}
+		object, err := parseObject(body)
+		if err != nil {
+			return apiError(http.StatusBadRequest, 2016, "Ошибка формата JSON: "+err.Error())
+		}
+		return http.StatusOK, server.expand(server.create(path, object), r.URL.Query().Get("expand"))
+	case len(parts) == 1 && parts[0] == "delete" && r.Method == http.MethodPost:
+		return server.bulkDelete(path, body)
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		object, ok := server.find(path, parts[0])
+		if !ok {
+			return notFound(path, parts[0])
+		}
+		return http.StatusOK, server.expand(object, r.URL.Query().Get("expand"))
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		object, err := parseObject(body)
+		if err != nil {
+			return apiError(http.StatusBadRequest, 2016, "Ошибка формата JSON: "+err.Error())
+		}
+		updated, ok := server.update(path, parts[0], object)
+		if !ok {
+			return notFound(path, parts[0])
+		}
+		return http.StatusOK, server.expand(updated, r.URL.Query().Get("expand"))
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		if !server.delete(path, parts[0]) {
+			return notFound(path, parts[0])
+		}
+		return http.StatusOK, nil
+	default:
+		return apiError(http.StatusMethodNotAllowed, 1002, fmt.Sprintf("Метод %s не поддерживается: entity/%s", r.Method, strings.Join(append([]string{path}, parts...), "/")))
+	}
+}
+
+// positionsPath возвращает путь коллекции позиций документа metaType с ID id.
+func positionsPath(metaType, id string) string {
+	return metaType + "/" + id + "/positions"
+}
+
+// list возвращает список объектов коллекции path с учётом параметров запроса.
+func (server *Server) list(r *http.Request, path string) (int, any) {
+	query := r.URL.Query()
+
+	q, err := parseQuery(query)
+	if err != nil {
+		return apiError(http.StatusBadRequest, 1002, err.Error())
+	}
+
+	var rows []map[string]any
+	if collection, ok := server.collections[path]; ok {
+		for _, id := range collection.ids {
+			object := collection.rows[id]
+			if q.match(object) {
+				rows = append(rows, object)
+			}
+		}
+	}
+
+	q.sort(rows)
+
+	size := len(rows)
+
+	// асинхронный запрос без limit возвращает все объекты
+	if query.Get("async") == "true" && query.Get("limit") == "" {
+		q.limit = max(size, 1)
+	}
+
+	rows = rows[min(q.offset, size):min(q.offset+q.limit, size)]
+
+	expanded := make([]map[string]any, 0, len(rows))
+	for _, row := range rows {
+		expanded = append(expanded, server.expand(row, query.Get("expand")))
+	}
+
+	href := server.BaseURL() + "entity/" + path
+	meta := map[string]any{
+		"href":      href,
+		"type":      metaTypeOf(path),
+		"mediaType": moysklad.ApplicationJson,
+		"size":      size,
+		"limit":     q.limit,
+		"offset":    q.offset,
+	}
+	if q.offset+q.limit < size {
+		meta["nextHref"] = fmt.Sprintf("%s?limit=%d&offset=%d", href, q.limit, q.offset+q.limit)
+	}
+	if q.offset > 0 {
+		meta["previousHref"] = fmt.Sprintf("%s?limit=%d&offset=%d", href, q.limit, max(q.offset-q.limit, 0))
+	}
+
+	return http.StatusOK, map[string]any{"meta": meta, "rows": expanded}
+}
+
+// bulkCreateUpdate создаёт и изменяет объекты массива body.
+//
+// Объекты с метаданными изменяются, остальные создаются.
+func (server *Server) bulkCreateUpdate(metaType string, body []byte) (int, any) {
+	objects, err := parseObjects(body)
+	if err != nil {
+		return apiError(http.StatusBadRequest, 2016, "Ошибка формата JSON: "+err.Error())
+	}
+
+	status := http.StatusOK
+	rows := make([]any, 0, len(objects))
+
+	for _, object := range objects {
+		_, id := parseHref(hrefOf(object))
+		if id == "" {
+			rows = append(rows, server.create(metaType, object))
+			continue
+		}
+
+		updated, ok := server.update(metaType, id, object)
+		if !ok {
+			status = http.StatusBadRequest
+			_, apiErr := notFound(metaType, id)
+			rows = append(rows, apiErr)
+			continue
+		}
+		rows = append(rows, updated)
+	}
+
+	return status, rows
+}
+
+// bulkDelete удаляет объекты, метаданные которых переданы в массиве body.
+func (server *Server) bulkDelete(metaType string, body []byte) (int, any) {
+	objects, err := parseObjects(body)
+	if err != nil {
+		return apiError(http.StatusBadRequest, 2016, "Ошибка формата JSON: "+err.Error())
+	}
+
+	status := http.StatusOK
+	rows := make([]any, 0, len(objects))
+
+	for _, object := range objects {
+		_, id := parseHref(hrefOf(object))
+		if !server.delete(metaType, id) {
+			status = http.StatusBadRequest
+			_, apiErr := notFound(metaType, id)
+			rows = append(rows, apiErr)
+			continue
+		}
+		rows = append(rows, map[string]any{"info": fmt.Sprintf("Сущность '%s' с UUID: %s успешно удалена", metaType, id)})
+	}
+
+	return status, rows
+}
+
+// create сохраняет объект сущности metaType, устанавливая ID, метаданные и время изменения.
+func (server *Server) create(metaType string, object map[string]any) map[string]any {
+	id, _ := object["id"].(string)
+	if id == "" {
+		id = newID()
+	}
+
+	now := moysklad.FormatTime(time.Now())
+
+	object["id"] = id
+	object["accountId"] = AccountID
+	object["meta"] = server.meta(metaType, id)
+	object["updated"] = now
+	if _, ok := object["created"]; !ok {
+		object["created"] = now
+	}
+
+	c, ok := server.collections[metaType]
+	if !ok {
+		c = &collection{rows: make(map[string]map[string]any)}
+		server.collections[metaType] = c
+	}
+	if _, ok := c.rows[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.rows[id] = object
+
+	return object
+}
+
+// update изменяет поля объекта сущности metaType с ID id.
+func (server *Server) update(metaType, id string, fields map[string]any) (map[string]any, bool) {
+	object, ok := server.find(metaType, id)
+	if !ok {
+		return nil, false
+	}
+
+	for field, value := range fields {
+		switch field {
+		case "id", "meta", "accountId", "created":
+			continue
+		}
+		if value == nil {
+			delete(object, field)
+			continue
+		}
+		object[field] = value
+	}
+	object["updated"] = moysklad.FormatTime(time.Now())
+
+	return object, true
+}
+
+// delete удаляет объект сущности metaType с ID id.
+func (server *Server) delete(metaType, id string) bool {
+	c, ok := server.collections[metaType]
+	if !ok {
+		return false
+	}
+	if _, ok := c.rows[id]; !ok {
+		return false
+	}
+
+	delete(c.rows, id)
+	delete(server.collections, positionsPath(metaType, id))
+	for i, rowID := range c.ids {
+		if rowID == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// find возвращает объект сущности metaType с ID id.
+func (server *Server) find(metaType, id string) (map[string]any, bool) {
+	c, ok := server.collections[metaType]
+	if !ok {
+		return nil, false
+	}
+	object, ok := c.rows[id]
+	return object, ok
+}
+
+// meta возвращает метаданные объекта с ID id коллекции path.
+func (server *Server) meta(path, id string) map[string]any {
+	meta := map[string]any{
+		"href":      server.BaseURL() + "entity/" + path + "/" + id,
+		"type":      metaTypeOf(path),
+		"mediaType": moysklad.ApplicationJson,
+	}
+	if !strings.Contains(path, "/") {
+		meta["metadataHref"] = server.BaseURL() + "entity/" + path + "/metadata"
+	}
+	return meta
+}
+
+// metaTypeOf возвращает код сущности объектов коллекции path.
+//
+// Позиции документа имеют код сущности документа с суффиксом position, например customerorderposition.
+func metaTypeOf(path string) string {
+	if metaType, _, ok := strings.Cut(path, "/"); ok {
+		return metaType + "position"
+	}
+	return path
+}
+
+// expand возвращает копию объекта, ссылки которого, указанные в параметре expand, заменены объектами.
+//
+// Поддерживаются вложенные ссылки через точку, например "agent.group".
+func (server *Server) expand(object map[string]any, expand string) map[string]any {
+	if expand == "" {
+		return object
+	}
+
+	result := copyObject(object)
+	for _, path := range strings.Split(expand, ",") {
+		server.expandPath(result, strings.Split(strings.TrimSpace(path), "."))
+	}
+	return result
+}
+
+// expandPath заменяет ссылку по пути path объектом.
+func (server *Server) expandPath(object map[string]any, path []string) {
+	if len(path) == 0 || path[0] == "" {
+		return
+	}
+
+	switch value := object[path[0]].(type) {
+	case map[string]any:
+		if rows, ok := value["rows"].([]any); ok {
+			for _, row := range rows {
+				if row, ok := row.(map[string]any); ok {
+					server.expandPath(row, path[1:])
+				}
+			}
+			return
+		}
+
+		resolved := server.resolve(value)
+		object[path[0]] = resolved
+		server.expandPath(resolved, path[1:])
+	case []any:
+		for i, item := range value {
+			if item, ok := item.(map[string]any); ok {
+				resolved := server.resolve(item)
+				value[i] = resolved
+				server.expandPath(resolved, path[1:])
+			}
+		}
+	}
+}
+
+// resolve возвращает копию объекта, на который ссылаются метаданные reference.
+//
+// Если объект не найден, возвращается reference.
+func (server *Server) resolve(reference map[string]any) map[string]any {
+	metaType, id := parseHref(hrefOf(reference))
+	if id == "" {
+		return reference
+	}
+
+	object, ok := server.find(metaType, id)
+	if !ok {
+		return reference
+	}
+	return copyObject(object)
+}
+
+// notFound возвращает ответ на запрос несуществующего объекта.
+func notFound(metaType, id string) (int, any) {
+	return apiError(http.StatusNotFound, 1021, fmt.Sprintf("Объект '%s' с UUID '%s' не найден", metaType, id))
+}
+
+// apiError возвращает ответ с ошибкой API.
+func apiError(status, code int, message string) (int, any) {
+	return status, moysklad.ApiErrors{ApiErrors: []*moysklad.ApiError{{Header: message, Code: code}}}
+}
+
+// writeError записывает ответ с ошибкой API.
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	status, response := apiError(status, code, message)
+	writeJSON(w, status, response)
+}
+
+// writeJSON записывает ответ с телом response в формате JSON.
+func writeJSON(w http.ResponseWriter, status int, response any) {
+	if response == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		status, response = apiError(http.StatusInternalServerError, 1000, err.Error())
+		data, _ = json.Marshal(response)
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// newID возвращает случайный идентификатор в формате UUID.
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
@@ -0,0 +1,186 @@
+package mstest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/mstest"
+)
+
+func TestServerRejectsNonObjectBody(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	tests := []struct {
+		method, path, body string
+	}{
+		{http.MethodPost, "entity/product", "null"},
+		{http.MethodPost, "entity/product", "1"},
+		{http.MethodPost, "entity/product", "[null]"},
+		{http.MethodPost, "entity/product/delete", "null"},
+		{http.MethodPut, "entity/product/00000000-0000-0000-0000-000000000000", "null"},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest(test.method, server.BaseURL()+test.path, strings.NewReader(test.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s %s %s: got status %d, want 400", test.method, test.path, test.body, resp.StatusCode)
+		}
+	}
+
+	if n := server.Len(moysklad.MetaTypeProduct); n != 0 {
+		t.Fatalf("got %d products, want 0", n)
+	}
+}
+
+func TestServerCRUD(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+	ctx := context.Background()
+
+	product, _, err := client.Entity().Product().Create(ctx, &moysklad.Product{Name: moysklad.String("Товар")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	product.Article = moysklad.String("A-1")
+	if _, _, err = client.Entity().Product().Update(ctx, product.GetID(), product); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, ok := mstest.Get[moysklad.Product](server, product.GetID())
+	if !ok || stored.GetName() != "Товар" || stored.GetArticle() != "A-1" {
+		t.Fatalf("got product %v, want updated product", stored)
+	}
+
+	list, _, err := client.Entity().Product().GetList(ctx, moysklad.WithFilterEquals("article", "A-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Rows.Len() != 1 {
+		t.Fatalf("got %d products, want 1", list.Rows.Len())
+	}
+
+	if _, _, err = client.Entity().Product().Delete(ctx, product); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = client.Entity().Product().GetByID(ctx, product.GetID()); !errors.Is(err, moysklad.ErrNotFound) {
+		t.Fatalf("got error %v, want ErrNotFound", err)
+	}
+}
+
+func TestServerPositions(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+	ctx := context.Background()
+
+	var products []*moysklad.Product
+	for _, name := range []string{"A", "B", "C"} {
+		product, _, err := client.Entity().Product().Create(ctx, &moysklad.Product{Name: moysklad.String(name)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		products = append(products, product)
+	}
+
+	order, _, err := client.Entity().CustomerOrder().Create(ctx, &moysklad.CustomerOrder{Name: moysklad.String("1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	position := func(product *moysklad.Product, quantity float64) *moysklad.CustomerOrderPosition {
+		return new(moysklad.CustomerOrderPosition).SetAssortment(product).SetQuantity(quantity)
+	}
+
+	service := client.Entity().CustomerOrder()
+	created, _, err := service.CreatePositionMany(ctx, order.GetID(), position(products[0], 1), position(products[1], 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.HasErrors() || created.Entities().Len() != 2 {
+		t.Fatalf("got created positions %s, want 2", created)
+	}
+
+	result, err := service.SyncPositions(ctx, order.GetID(), moysklad.Slice[moysklad.CustomerOrderPosition]{
+		position(products[1], 3),
+		position(products[2], 1),
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Created.Len() != 1 || result.Updated.Len() != 1 || result.Deleted.Len() != 1 || result.Unchanged != 0 {
+		t.Fatalf("got sync result %s, want 1 created, 1 updated, 1 deleted", result)
+	}
+
+	positions, _, err := service.GetPositionListAll(ctx, order.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if positions.Len() != 2 {
+		t.Fatalf("got %d positions, want 2", positions.Len())
+	}
+
+	for _, position := range *positions {
+		if position.GetAssortment().GetMeta().GetHref() == products[1].GetMeta().GetHref() && position.GetQuantity() != 3 {
+			t.Fatalf("got quantity %v, want 3", position.GetQuantity())
+		}
+	}
+
+	// повторная синхронизация не изменяет позиции
+	result, err = service.SyncPositions(ctx, order.GetID(), moysklad.Slice[moysklad.CustomerOrderPosition]{
+		position(products[1], 3),
+		position(products[2], 1),
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Unchanged != 2 {
+		t.Fatalf("got sync result %s, want 2 unchanged", result)
+	}
+
+	if _, _, err = service.DeletePositionMany(ctx, order.GetID(), *positions...); err != nil {
+		t.Fatal(err)
+	}
+	if positions, _, err = service.GetPositionListAll(ctx, order.GetID()); err != nil || positions.Len() != 0 {
+		t.Fatalf("got %d positions after delete (%v), want 0", positions.Len(), err)
+	}
+}
+
+func TestServerThrottle(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+	ctx := context.Background()
+
+	server.Throttle(1)
+
+	if _, _, err := client.Entity().Product().GetList(ctx); !errors.Is(err, moysklad.ErrRateLimited) {
+		t.Fatalf("got error %v, want ErrRateLimited", err)
+	}
+	if _, _, err := client.Entity().Product().GetList(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := server.Requests(); n != 2 {
+		t.Fatalf("got %d requests, want 2", n)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 	"net/http"
 )
 
@@ -1180,6 +1181,21 @@ type NotificationService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Notification], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех уведомлений.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Notification, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех уведомлений.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Notification]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех уведомлений асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Notification, error]
+
 	// GetByID выполняет запрос на получение отдельного уведомления по ID.
 	// Принимает контекст, ID уведомления и опционально объект параметров запроса Params.
 	// Возвращает найденное уведомление.
@@ -1228,12 +1244,18 @@ type notificationService struct {
 func (service *notificationService) MarkAsRead(ctx context.Context, id string) (bool, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointNotificationMarkAsRead, id)
 	_, resp, err := NewRequestBuilder[any](service.client, path).Put(ctx, nil)
-	return resp.StatusCode() == http.StatusOK, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusOK, resp, nil
 }
 
 func (service *notificationService) MarkAsReadAll(ctx context.Context) (bool, *resty.Response, error) {
 	_, resp, err := NewRequestBuilder[any](service.client, EndpointNotificationMarkAsReadAll).Put(ctx, nil)
-	return resp.StatusCode() == http.StatusOK, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusOK, resp, nil
 }
 
 func (service *notificationService) GetSubscription(ctx context.Context) (*NotificationSubscription, *resty.Response, error) {
@@ -1242,7 +1264,10 @@ func (service *notificationService) GetSubscription(ctx context.Context) (*Notif
 
 func (service *notificationService) UpdateSubscription(ctx context.Context, notificationSubscription *NotificationSubscription) (bool, *resty.Response, error) {
 	_, resp, err := NewRequestBuilder[any](service.client, EndpointNotificationSubscription).Put(ctx, notificationSubscription)
-	return resp.StatusCode() == http.StatusOK, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusOK, resp, nil
 }
 
 // NewNotificationService принимает [Client] и возвращает сервис для работы с уведомлениями.
@@ -7,7 +7,7 @@ type Operation struct {
 	Group     *Group         `json:"group,omitempty"`     // Отдел сотрудника
 	Meta      *Meta          `json:"meta,omitempty"`      // Метаданные операции
 	Name      *string        `json:"name,omitempty"`      // Наименование операции
-	LinkedSum *float64       `json:"linkedSum,omitempty"` // Сумма, оплаченную по данному документу
+	LinkedSum *Money         `json:"linkedSum,omitempty"` // Сумма, оплаченную по данному документу
 	AccountID *string        `json:"accountId,omitempty"` // ID учётной записи
 	ID        *string        `json:"id,omitempty"`        // ID операции
 	raw       []byte         // сырые данные для последующей конвертации в нужный тип
@@ -41,7 +41,7 @@ func (operation Operation) GetPayments() Slice[Payment] {
 
 // GetLinkedSum возвращает Сумму, оплаченную по данному документу.
 func (operation Operation) GetLinkedSum() float64 {
-	return Deref(operation.LinkedSum)
+	return Deref(operation.LinkedSum).Float()
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -62,7 +62,7 @@ func (operation *Operation) SetMeta(meta *Meta) *Operation {
 
 // SetLinkedSum устанавливает Сумму, оплаченную по данному документу.
 func (operation *Operation) SetLinkedSum(linkedSum float64) *Operation {
-	operation.LinkedSum = &linkedSum
+	operation.LinkedSum = NewMoney(MoneyFromFloat(linkedSum))
 	return operation
 }
 
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -686,6 +687,21 @@ type OrganizationService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Organization], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех юрлиц.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Organization, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех юрлиц.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Organization]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех юрлиц асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Organization, error]
+
 	// Create выполняет запрос на создание юрлица.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование Юрлица)
@@ -696,13 +712,13 @@ type OrganizationService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение юрлиц.
 	// Изменяемые юрлица должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список юрлиц и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых юрлиц.
-	CreateUpdateMany(ctx context.Context, organizationList Slice[Organization], params ...func(*Params)) (*Slice[Organization], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых юрлиц и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, organizationList Slice[Organization], params ...func(*Params)) (*BulkResult[Organization], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление юрлиц.
 	// Принимает контекст и множество юрлиц.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Organization) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Organization) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление юрлица по ID.
 	// Принимает контекст и ID юрлица.
@@ -747,8 +763,8 @@ type OrganizationService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -762,8 +778,8 @@ type OrganizationService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetAccountList выполняет запрос на получение списка счетов юрлица.
 	// Принимает контекст и ID юрлица.
@@ -6,13 +6,13 @@ package moysklad
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-priemka-priemki-nakladnye-rashody
 type Overhead struct {
-	Sum          *float64     `json:"sum,omitempty"`          // Сумма в копейках
+	Sum          *Money       `json:"sum,omitempty"`          // Сумма в копейках
 	Distribution Distribution `json:"distribution,omitempty"` // Распределение накладных расходов
 }
 
 // GetSum возвращает Сумму в копейках.
 func (overhead Overhead) GetSum() float64 {
-	return Deref(overhead.Sum)
+	return Deref(overhead.Sum).Float()
 }
 
 // GetDistribution возвращает Распределение накладных расходов.
@@ -22,7 +22,7 @@ func (overhead Overhead) GetDistribution() Distribution {
 
 // SetSum устанавливает Сумму в копейках.
 func (overhead *Overhead) SetSum(sum float64) *Overhead {
-	overhead.Sum = &sum
+	overhead.Sum = NewMoney(MoneyFromFloat(sum))
 	return overhead
 }
 
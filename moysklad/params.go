@@ -1,10 +1,12 @@
 package moysklad
 
 import (
+	"errors"
 	"fmt"
 	"github.com/google/go-querystring/query"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -25,6 +27,11 @@ type Params struct {
 	Offset      int        `url:"offset,omitempty"`         // Смещение от первого элемента (считается с нуля)
 	Limit       int        `url:"limit,omitempty"`          // Количество элементов на странице (по умолчанию 1000, максимум 1000)
 	Async       bool       `url:"async,omitempty"`          // Параметр создания асинхронной задачи
+	stable      bool       // Получение списка окнами по полям updated и id вместо смещения (см. WithStablePagination)
+	err         error      // Ошибка построения параметров, возвращаемая вместо выполнения запроса
+
+	// Часовой пояс учётной записи клиента
+	location *time.Location
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -32,14 +39,35 @@ func (params *Params) String() string {
 	return params.Values().Encode()
 }
 
+// Err возвращает ошибку построения параметров запроса, например, ошибку в выражении фильтрации [WithFilterExpr].
+//
+// Запрос с такими параметрами не выполняется, а ошибка возвращается из метода сервиса.
+func (params *Params) Err() error {
+	return params.err
+}
+
 func (params *Params) Values() url.Values {
 	v, _ := query.Values(params)
 
 	return v
 }
 
+// Location возвращает часовой пояс, в котором передаются даты параметров запроса:
+// часовой пояс учётной записи клиента или часовой пояс по умолчанию (см. [Location]).
+func (params *Params) Location() *time.Location {
+	if params.location != nil {
+		return params.location
+	}
+	return Location()
+}
+
 func ApplyParams(params []func(*Params)) *Params {
-	p := &Params{}
+	return applyParams(nil, params)
+}
+
+// applyParams применяет параметры params к параметрам запроса с часовым поясом loc.
+func applyParams(loc *time.Location, params []func(*Params)) *Params {
+	p := &Params{location: loc}
 
 	for _, o := range params {
 		o(p)
@@ -184,7 +212,7 @@ func (groupByType GroupBy) String() string {
 // momentFrom=value
 func WithMomentFrom(momentFrom time.Time) func(*Params) {
 	return func(params *Params) {
-		params.MomentFrom = momentFrom.Format(time.DateTime)
+		params.MomentFrom = momentFrom.In(params.Location()).Format(time.DateTime)
 	}
 }
 
@@ -193,7 +221,7 @@ func WithMomentFrom(momentFrom time.Time) func(*Params) {
 // momentTo=value
 func WithMomentTo(momentTo time.Time) func(*Params) {
 	return func(params *Params) {
-		params.MomentFrom = momentTo.Format(time.DateTime)
+		params.MomentTo = momentTo.In(params.Location()).Format(time.DateTime)
 	}
 }
 
@@ -261,6 +289,26 @@ func WithStockFiled() func(*Params) {
 	}
 }
 
+// WithStablePagination включает устойчивый к изменениям режим получения всех объектов
+// для методов GetListAll и GetListSeq.
+//
+// Вместо смещения offset объекты запрашиваются окнами, отсортированными по полям updated и id,
+// с фильтрацией updated>=значение, а повторы на границе окон исключаются по ID.
+// Каждый объект, существовавший и не изменявшийся на протяжении всего перебора, будет получен ровно один раз,
+// даже если в это время создаются, изменяются или удаляются другие объекты.
+// Объект, изменённый во время перебора, может быть получен повторно с новым значением updated.
+//
+// Поддерживается только для объектов с полями id и updated (методы GetID и GetUpdated),
+// для остальных запрос завершается ошибкой без обращения к API.
+// Сортировка, указанная в параметрах, не учитывается.
+//
+// order=updated,asc;id,asc&filter=updated>=value
+func WithStablePagination() func(*Params) {
+	return func(params *Params) {
+		params.stable = true
+	}
+}
+
 // WithExpand Замена ссылок объектами.
 //
 // expand=fieldName1,fieldName2,...
@@ -278,7 +326,46 @@ func WithExpand(fields ...string) func(*Params) {
 }
 
 func newFilter(key, value string, filterType FilterType) string {
-	return fmt.Sprintf("%s%s%s", key, filterType, value)
+	return fmt.Sprintf("%s%s%s", key, filterType, EscapeFilterValue(value))
+}
+
+// EscapeFilterValue экранирует символ ";" в значении фильтрации.
+//
+// Символ ";" разделяет условия фильтрации, поэтому в значении он должен быть экранирован обратным слешем.
+func EscapeFilterValue(value string) string {
+	return strings.ReplaceAll(value, ";", `\;`)
+}
+
+// FilterExpr описывает выражение фильтрации.
+//
+// Выражения строятся с помощью пакета filter.
+type FilterExpr interface {
+	// FilterConditions возвращает условия фильтрации в формате "ключ оператор значение",
+	// в которых моменты времени переданы в часовом поясе loc, или ошибку, если выражение построено неверно.
+	FilterConditions(loc *time.Location) ([]string, error)
+}
+
+// WithFilterExpr добавляет условия фильтрации из выражений exprs.
+//
+// Если выражение построено неверно, запрос не выполняется, а метод сервиса возвращает ошибку.
+//
+// Например:
+//
+//	moysklad.WithFilterExpr(
+//		filter.Meta("agent").In(counterparty1, counterparty2),
+//		filter.Field("moment").Between(from, to),
+//	)
+func WithFilterExpr(exprs ...FilterExpr) func(*Params) {
+	return func(params *Params) {
+		for _, expr := range exprs {
+			conditions, err := expr.FilterConditions(params.Location())
+			if err != nil {
+				params.err = errors.Join(params.err, err)
+				continue
+			}
+			params.Filter = append(params.Filter, conditions...)
+		}
+	}
 }
 
 // WithFilterObject принимает объект, реализующий интерфейс [MetaOwner] и передаёт его ссылку в качестве фильтрации.
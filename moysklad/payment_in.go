@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -45,7 +46,7 @@ type PaymentIn struct {
 	Shared              *bool                    `json:"shared,omitempty"`              // Общий доступ
 	SalesChannel        *NullValue[SalesChannel] `json:"salesChannel,omitempty"`        // Метаданные канала продаж
 	State               *NullValue[State]        `json:"state,omitempty"`               // Метаданные статуса Входящего платежа
-	Sum                 *float64                 `json:"sum,omitempty"`                 // Сумма Входящего платежа в установленной валюте
+	Sum                 *Money                   `json:"sum,omitempty"`                 // Сумма Входящего платежа в установленной валюте
 	SyncID              *string                  `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp               `json:"updated,omitempty"`             // Момент последнего обновления Входящего платежа
 	AccountID           *string                  `json:"accountId,omitempty"`           // ID учётной записи
@@ -232,7 +233,7 @@ func (paymentIn PaymentIn) GetState() State {
 
 // GetSum возвращает Сумму Входящего платежа в установленной валюте.
 func (paymentIn PaymentIn) GetSum() float64 {
-	return Deref(paymentIn.Sum)
+	return Deref(paymentIn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -449,7 +450,7 @@ func (paymentIn *PaymentIn) SetState(state *State) *PaymentIn {
 
 // SetSum устанавливает Сумму Входящего платежа в установленной валюте.
 func (paymentIn *PaymentIn) SetSum(sum float64) *PaymentIn {
-	paymentIn.Sum = &sum
+	paymentIn.Sum = NewMoney(MoneyFromFloat(sum))
 	return paymentIn
 }
 
@@ -509,6 +510,21 @@ type PaymentInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PaymentIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех входящих платежей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PaymentIn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех входящих платежей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[PaymentIn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех входящих платежей асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*PaymentIn, error]
+
 	// Create выполняет запрос на создание входящего платежа.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -520,13 +536,13 @@ type PaymentInService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение входящих платежей.
 	// Изменяемые входящие платежи должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список входящих платежей и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых входящих платежей.
-	CreateUpdateMany(ctx context.Context, paymentInList Slice[PaymentIn], params ...func(*Params)) (*Slice[PaymentIn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых входящих платежей и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, paymentInList Slice[PaymentIn], params ...func(*Params)) (*BulkResult[PaymentIn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление входящих платежей.
 	// Принимает контекст и множество входящих платежей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*PaymentIn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*PaymentIn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление входящего платежа по ID.
 	// Принимает контекст и ID входящего платежа.
@@ -587,8 +603,8 @@ type PaymentInService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -602,8 +618,8 @@ type PaymentInService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -657,8 +673,8 @@ type PaymentInService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -45,10 +46,10 @@ type PaymentOut struct {
 	SalesChannel        *NullValue[SalesChannel] `json:"salesChannel,omitempty"`        // Метаданные канала продаж
 	Shared              *bool                    `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]        `json:"state,omitempty"`               // Метаданные статуса Исходящего платежа
-	Sum                 *float64                 `json:"sum,omitempty"`                 // Сумма Исходящего платежа в установленной валюте
+	Sum                 *Money                   `json:"sum,omitempty"`                 // Сумма Исходящего платежа в установленной валюте
 	SyncID              *string                  `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp               `json:"updated,omitempty"`             // Момент последнего обновления Исходящего платежа
-	VatSum              *float64                 `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Money                   `json:"vatSum,omitempty"`              // Сумма НДС
 	AccountID           *string                  `json:"accountId,omitempty"`           // ID учётной записи
 	Attributes          Slice[Attribute]         `json:"attributes,omitempty"`          // Список метаданных доп. полей
 }
@@ -237,7 +238,7 @@ func (paymentOut PaymentOut) GetState() State {
 
 // GetSum возвращает Сумму Исходящего платежа в копейках.
 func (paymentOut PaymentOut) GetSum() float64 {
-	return Deref(paymentOut.Sum)
+	return Deref(paymentOut.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -252,7 +253,7 @@ func (paymentOut PaymentOut) GetUpdated() time.Time {
 
 // GetVatSum возвращает Сумму НДС.
 func (paymentOut PaymentOut) GetVatSum() float64 {
-	return Deref(paymentOut.VatSum)
+	return Deref(paymentOut.VatSum).Float()
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -460,7 +461,7 @@ func (paymentOut *PaymentOut) SetState(state *State) *PaymentOut {
 
 // SetSum устанавливает Сумму Исходящего платежа в установленной валюте.
 func (paymentOut *PaymentOut) SetSum(sum float64) *PaymentOut {
-	paymentOut.Sum = &sum
+	paymentOut.Sum = NewMoney(MoneyFromFloat(sum))
 	return paymentOut
 }
 
@@ -515,6 +516,21 @@ type PaymentOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PaymentOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех исходящих платежей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PaymentOut, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех исходящих платежей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[PaymentOut]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех исходящих платежей асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*PaymentOut, error]
+
 	// Create выполняет запрос на создание исходящего платежа.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -527,13 +543,13 @@ type PaymentOutService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение исходящих платежей.
 	// Изменяемые исходящие платежи должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список исходящих платежей и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых исходящих платежей.
-	CreateUpdateMany(ctx context.Context, paymentOutList Slice[PaymentOut], params ...func(*Params)) (*Slice[PaymentOut], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых исходящих платежей и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, paymentOutList Slice[PaymentOut], params ...func(*Params)) (*BulkResult[PaymentOut], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление исходящих платежей.
 	// Принимает контекст и множество исходящих платежей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*PaymentOut) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*PaymentOut) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление исходящего платежа по ID.
 	// Принимает контекст и ID исходящего платежа.
@@ -594,8 +610,8 @@ type PaymentOutService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -609,8 +625,8 @@ type PaymentOutService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -664,8 +680,8 @@ type PaymentOutService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
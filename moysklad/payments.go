@@ -34,11 +34,11 @@ type Payment struct {
 	SalesChannel   *NullValue[SalesChannel] `json:"salesChannel,omitempty"`   // Метаданные канала продаж
 	Shared         *bool                    `json:"shared,omitempty"`         // Общий доступ
 	State          *State                   `json:"state,omitempty"`          // Метаданные статуса платежа
-	Sum            *float64                 `json:"sum,omitempty"`            // Сумма платежа в копейках
+	Sum            *Money                   `json:"sum,omitempty"`            // Сумма платежа в копейках
 	SyncID         *string                  `json:"syncId,omitempty"`         // ID синхронизации
 	Updated        *Timestamp               `json:"updated,omitempty"`        // Момент последнего обновления платежа
-	VatSum         *float64                 `json:"vatSum,omitempty"`         // Сумма НДС
-	LinkedSum      *float64                 `json:"linkedSum,omitempty"`      // Сумма, оплаченная по документу из этого платежа
+	VatSum         *Money                   `json:"vatSum,omitempty"`         // Сумма НДС
+	LinkedSum      *Money                   `json:"linkedSum,omitempty"`      // Сумма, оплаченная по документу из этого платежа
 	Operations     Operations               `json:"operations,omitempty"`     // Массив ссылок на связанные операции в формате Метаданных
 	raw            []byte                   // сырые данные для последующей конвертации в нужный тип
 }
@@ -180,7 +180,7 @@ func (payment Payment) GetState() State {
 
 // GetSum возвращает Сумму платежа в копейках.
 func (payment Payment) GetSum() float64 {
-	return Deref(payment.Sum)
+	return Deref(payment.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -195,12 +195,12 @@ func (payment Payment) GetUpdated() time.Time {
 
 // GetVatSum возвращает Сумму НДС.
 func (payment Payment) GetVatSum() float64 {
-	return Deref(payment.VatSum)
+	return Deref(payment.VatSum).Float()
 }
 
 // GetLinkedSum возвращает Сумму, оплаченную по документу из этого платежа.
 func (payment Payment) GetLinkedSum() float64 {
-	return Deref(payment.LinkedSum)
+	return Deref(payment.LinkedSum).Float()
 }
 
 // GetOperations возвращает Метаданные связанных операций.
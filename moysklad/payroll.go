@@ -19,7 +19,7 @@ type Payroll struct {
 	ExternalCode *string          `json:"externalCode,omitempty"` // Внешний код Начисления зарплаты
 	Moment       *Timestamp       `json:"moment,omitempty"`       // Дата документа
 	Applicable   *bool            `json:"applicable,omitempty"`   // Отметка о проведении
-	Sum          *float64         `json:"sum,omitempty"`          // Сумма в копейках
+	Sum          *Money           `json:"sum,omitempty"`          // Сумма в копейках
 	Organization *Organization    `json:"organization,omitempty"` // Метаданные юрлица
 	Created      *Timestamp       `json:"created,omitempty"`      // Момент создания
 	Printed      *bool            `json:"printed,omitempty"`      // Напечатан ли документ
@@ -90,7 +90,7 @@ func (payroll Payroll) GetApplicable() bool {
 
 // GetSum возвращает Сумму в копейках.
 func (payroll Payroll) GetSum() float64 {
-	return Deref(payroll.Sum)
+	return Deref(payroll.Sum).Float()
 }
 
 // GetOrganization возвращает Метаданные юрлица.
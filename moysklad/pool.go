@@ -0,0 +1,418 @@
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultPoolIdleTimeout время простоя по умолчанию, после которого клиент удаляется из [ClientPool].
+const DefaultPoolIdleTimeout = 30 * time.Minute
+
+// ErrPoolClosed возвращается при обращении к закрытому [ClientPool].
+var ErrPoolClosed = errors.New("moysklad: client pool closed")
+
+// Credentials учётные данные аккаунта.
+//
+// Используется источник токена, токен или логин и пароль (в порядке приоритета).
+type Credentials struct {
+	TokenSource TokenSource    // Источник токена аккаунта
+	Location    *time.Location // Часовой пояс учётной записи. Если не указан, используется Config.Location пула
+	Token       string         // Токен
+	Username    string         // Логин
+	Password    string         // Пароль
+}
+
+// CredentialsProvider возвращает учётные данные аккаунта для [ClientPool].
+type CredentialsProvider interface {
+	// Credentials возвращает учётные данные аккаунта с ID accountID.
+	Credentials(ctx context.Context, accountID string) (*Credentials, error)
+}
+
+// CredentialsProviderFunc функция, реализующая интерфейс [CredentialsProvider].
+type CredentialsProviderFunc func(ctx context.Context, accountID string) (*Credentials, error)
+
+// Credentials реализует интерфейс [CredentialsProvider].
+func (f CredentialsProviderFunc) Credentials(ctx context.Context, accountID string) (*Credentials, error) {
+	return f(ctx, accountID)
+}
+
+// ClientPoolOptions параметры [ClientPool].
+type ClientPoolOptions struct {
+	// Базовая конфигурация клиентов. Учётные данные (в том числе TokenSource) заменяются данными из [CredentialsProvider].
+	//
+	// Ограничения MaxConcurrentRequests и MinRequestInterval применяются к каждому аккаунту отдельно.
+	Config Config
+
+	// Время простоя, после которого клиент удаляется из пула.
+	//
+	// По умолчанию [DefaultPoolIdleTimeout]. Отрицательное значение отключает удаление.
+	IdleTimeout time.Duration
+
+	// Максимальное количество одновременно выполняемых запросов всех аккаунтов.
+	//
+	// Ожидающие запросы получают очередь поочерёдно по аккаунтам, поэтому аккаунт с большим количеством запросов
+	// не вытесняет остальные. Нулевое значение – без ограничения.
+	MaxConcurrent int
+}
+
+// ClientPool пул клиентов для работы с несколькими аккаунтами.
+//
+// Клиент аккаунта создаётся при первом обращении по учётным данным из [CredentialsProvider]
+// и имеет собственные ограничения на количество запросов. Клиенты, не выполнявшие запросы
+// дольше IdleTimeout, удаляются из пула.
+//
+// # Пример:
+//
+//	pool := moysklad.NewClientPool(provider, moysklad.ClientPoolOptions{MaxConcurrent: 50})
+//	defer pool.Close()
+//
+//	client, err := pool.Client(ctx, accountID)
+//	if err != nil {
+//		return err
+//	}
+//
+//	products, _, err := client.Entity().Product().GetListAll(ctx)
+type ClientPool struct {
+	provider  CredentialsProvider
+	options   ClientPoolOptions
+	scheduler *fairScheduler
+
+	mu      sync.Mutex
+	entries map[string]*poolEntry
+	limits  map[string]*queryLimits // Ограничения запросов аккаунтов, общие для всех клиентов аккаунта
+	async   map[string]asyncQueue   // Очереди асинхронных задач аккаунтов, общие для всех клиентов аккаунта
+	closed  bool
+	stop    chan struct{}
+}
+
+// poolEntry клиент аккаунта в пуле.
+type poolEntry struct {
+	ready    chan struct{} // Закрывается после создания клиента
+	client   *Client
+	err      error
+	mu       sync.Mutex
+	lastUsed time.Time
+	active   int
+}
+
+// NewClientPool возвращает [ClientPool] с учётными данными из provider.
+func NewClientPool(provider CredentialsProvider, options ClientPoolOptions) *ClientPool {
+	if options.IdleTimeout == 0 {
+		options.IdleTimeout = DefaultPoolIdleTimeout
+	}
+
+	pool := &ClientPool{
+		provider: provider,
+		options:  options,
+		entries:  make(map[string]*poolEntry),
+		limits:   make(map[string]*queryLimits),
+		async:    make(map[string]asyncQueue),
+		stop:     make(chan struct{}),
+	}
+
+	if options.MaxConcurrent > 0 {
+		pool.scheduler = newFairScheduler(options.MaxConcurrent)
+	}
+
+	if options.IdleTimeout > 0 {
+		go pool.evictLoop()
+	}
+
+	return pool
+}
+
+// Client возвращает клиент аккаунта с ID accountID, создавая его при необходимости.
+//
+// Отмена ctx прерывает ожидание клиента, но не его создание: клиент получат другие вызовы для того же аккаунта.
+func (pool *ClientPool) Client(ctx context.Context, accountID string) (*Client, error) {
+	pool.mu.Lock()
+	if pool.closed {
+		pool.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+
+	entry, ok := pool.entries[accountID]
+	if !ok {
+		entry = &poolEntry{ready: make(chan struct{}), lastUsed: time.Now()}
+		pool.entries[accountID] = entry
+	}
+	pool.mu.Unlock()
+
+	if !ok {
+		// клиент создаётся без отмены контекста первого вызова:
+		// её получает только этот вызов, а другие вызовы, ожидающие клиент, получают созданный клиент
+		go func() {
+			entry.client, entry.err = pool.newClient(context.WithoutCancel(ctx), accountID, entry)
+			if entry.err != nil {
+				pool.remove(accountID, entry)
+			}
+			close(entry.ready)
+		}()
+	}
+
+	select {
+	case <-entry.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if entry.err != nil {
+		return nil, entry.err
+	}
+
+	entry.touch(0)
+	return entry.client, nil
+}
+
+// Invalidate удаляет клиент аккаунта с ID accountID из пула.
+//
+// Следующее обращение создаст клиент с актуальными учётными данными (например, после смены токена).
+// Новый клиент использует те же ограничения на количество запросов и асинхронных задач, что и удалённый,
+// поэтому клиенты аккаунта, полученные до и после вызова, не превышают ограничения вместе.
+func (pool *ClientPool) Invalidate(accountID string) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	delete(pool.entries, accountID)
+}
+
+// Len возвращает количество клиентов в пуле.
+func (pool *ClientPool) Len() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	return len(pool.entries)
+}
+
+// Close удаляет все клиенты и завершает работу пула.
+func (pool *ClientPool) Close() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if pool.closed {
+		return
+	}
+
+	pool.closed = true
+	pool.entries = make(map[string]*poolEntry)
+	pool.limits = make(map[string]*queryLimits)
+	pool.async = make(map[string]asyncQueue)
+	close(pool.stop)
+}
+
+// newClient создаёт клиент аккаунта с учётными данными из [CredentialsProvider].
+func (pool *ClientPool) newClient(ctx context.Context, accountID string, entry *poolEntry) (*Client, error) {
+	credentials, err := pool.provider.Credentials(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	config := pool.options.Config
+	config.TokenSource = credentials.TokenSource
+	config.Token = credentials.Token
+	config.Username = credentials.Username
+	config.Password = credentials.Password
+	if credentials.Location != nil {
+		config.Location = credentials.Location
+	}
+	config.Middlewares = append([]Middleware{pool.middleware(accountID, entry)}, config.Middlewares...)
+
+	client := New(config)
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	// ограничения запросов и асинхронных задач переходят к клиентам, созданным после Invalidate
+	if limits, ok := pool.limits[accountID]; ok {
+		client.limits = limits
+		client.metrics.SetMaxInFlight(limits.slots.capacity)
+	} else {
+		pool.limits[accountID] = client.limits
+	}
+	if async, ok := pool.async[accountID]; ok {
+		client.asyncTasks = async
+	} else {
+		pool.async[accountID] = client.asyncTasks
+	}
+
+	return client, nil
+}
+
+// middleware возвращает обработчик, который учитывает использование клиента
+// и распределяет запросы аккаунтов через общую очередь.
+func (pool *ClientPool) middleware(accountID string, entry *poolEntry) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, request *RequestInfo) (*ResponseInfo, error) {
+			entry.touch(1)
+			defer entry.touch(-1)
+
+			if pool.scheduler != nil {
+				if err := pool.scheduler.acquire(ctx, accountID); err != nil {
+					return nil, err
+				}
+				defer pool.scheduler.release()
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// remove удаляет entry из пула, если она соответствует аккаунту accountID.
+func (pool *ClientPool) remove(accountID string, entry *poolEntry) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if pool.entries[accountID] == entry {
+		delete(pool.entries, accountID)
+	}
+}
+
+// evictLoop периодически удаляет клиенты, простаивающие дольше IdleTimeout.
+func (pool *ClientPool) evictLoop() {
+	ticker := time.NewTicker(max(pool.options.IdleTimeout/2, time.Second))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-pool.stop:
+			return
+		case now := <-ticker.C:
+			pool.evict(now)
+		}
+	}
+}
+
+// evict удаляет клиенты без выполняемых запросов, простаивающие дольше IdleTimeout на момент now.
+//
+// Ограничения запросов аккаунта удаляются вместе с клиентом, если у них нет выполняемых и ожидающих запросов,
+// очередь асинхронных задач – если в ней нет незавершённых задач.
+func (pool *ClientPool) evict(now time.Time) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for accountID, entry := range pool.entries {
+		if entry.idle(now) <= pool.options.IdleTimeout {
+			continue
+		}
+
+		delete(pool.entries, accountID)
+
+		if limits, ok := pool.limits[accountID]; ok && limits.idle() {
+			delete(pool.limits, accountID)
+		}
+		if async, ok := pool.async[accountID]; ok && len(async) == 0 {
+			delete(pool.async, accountID)
+		}
+	}
+}
+
+// touch отмечает использование клиента и изменяет количество выполняемых запросов на delta.
+func (entry *poolEntry) touch(delta int) {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	entry.active += delta
+	entry.lastUsed = time.Now()
+}
+
+// idle возвращает время простоя клиента на момент now. Клиент с выполняемыми запросами не простаивает.
+func (entry *poolEntry) idle(now time.Time) time.Duration {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.active > 0 {
+		return 0
+	}
+	return now.Sub(entry.lastUsed)
+}
+
+// fairScheduler ограничивает количество одновременно выполняемых запросов
+// и выдаёт освободившиеся места ожидающим аккаунтам по очереди.
+type fairScheduler struct {
+	mu       sync.Mutex
+	capacity int
+	running  int
+	waiters  map[string][]chan struct{} // Ожидающие запросы по аккаунтам
+	order    []string                   // Очередь аккаунтов с ожидающими запросами
+}
+
+func newFairScheduler(capacity int) *fairScheduler {
+	return &fairScheduler{capacity: capacity, waiters: make(map[string][]chan struct{})}
+}
+
+// acquire занимает место для запроса аккаунта accountID.
+//
+// Возвращает ошибку ctx.Err() при отмене контекста.
+func (scheduler *fairScheduler) acquire(ctx context.Context, accountID string) error {
+	scheduler.mu.Lock()
+	if scheduler.running < scheduler.capacity && len(scheduler.order) == 0 {
+		scheduler.running++
+		scheduler.mu.Unlock()
+		return nil
+	}
+
+	ready := make(chan struct{})
+	if len(scheduler.waiters[accountID]) == 0 {
+		scheduler.order = append(scheduler.order, accountID)
+	}
+	scheduler.waiters[accountID] = append(scheduler.waiters[accountID], ready)
+	scheduler.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	queue := scheduler.waiters[accountID]
+	i := slices.Index(queue, ready)
+	if i < 0 {
+		// место уже выдано, возвращаем его следующему запросу
+		scheduler.handOff()
+		return ctx.Err()
+	}
+
+	scheduler.waiters[accountID] = slices.Delete(queue, i, i+1)
+	if len(scheduler.waiters[accountID]) == 0 {
+		delete(scheduler.waiters, accountID)
+		scheduler.order = slices.DeleteFunc(scheduler.order, func(id string) bool { return id == accountID })
+	}
+	return ctx.Err()
+}
+
+// release освобождает место запроса.
+func (scheduler *fairScheduler) release() {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	scheduler.handOff()
+}
+
+// handOff передаёт место первому запросу следующего по очереди аккаунта или освобождает его.
+func (scheduler *fairScheduler) handOff() {
+	if len(scheduler.order) == 0 {
+		scheduler.running--
+		return
+	}
+
+	accountID := scheduler.order[0]
+	scheduler.order = scheduler.order[1:]
+
+	queue := scheduler.waiters[accountID]
+	ready := queue[0]
+	if len(queue) > 1 {
+		scheduler.waiters[accountID] = queue[1:]
+		scheduler.order = append(scheduler.order, accountID)
+	} else {
+		delete(scheduler.waiters, accountID)
+	}
+
+	close(ready)
+}
@@ -0,0 +1,277 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/mstest"
+)
+
+// authServer возвращает тестовый сервер, сохраняющий токены запросов.
+func authServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		tokens []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens = append(tokens, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"size":0},"rows":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), tokens...)
+	}
+}
+
+func TestClientPoolCredentials(t *testing.T) {
+	server, tokens := authServer(t)
+
+	provider := moysklad.CredentialsProviderFunc(func(ctx context.Context, accountID string) (*moysklad.Credentials, error) {
+		if accountID == "source" {
+			return &moysklad.Credentials{TokenSource: moysklad.StaticTokenSource("token-source")}, nil
+		}
+		return &moysklad.Credentials{Token: "token-" + accountID}, nil
+	})
+
+	pool := moysklad.NewClientPool(provider, moysklad.ClientPoolOptions{
+		Config: moysklad.Config{
+			BaseURL:     server.URL,
+			TokenSource: moysklad.StaticTokenSource("token-base"),
+		},
+	})
+	defer pool.Close()
+
+	ctx := context.Background()
+	for _, accountID := range []string{"a", "b", "source"} {
+		client, err := pool.Client(ctx, accountID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = client.Entity().Product().GetList(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{"token-a", "token-b", "token-source"}
+	got := tokens()
+	if len(got) != len(want) {
+		t.Fatalf("got %d requests, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("request %d: got token %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestClientPoolLocation(t *testing.T) {
+	server, _ := authServer(t)
+
+	loc := time.FixedZone("UTC+5", 5*60*60)
+	provider := moysklad.CredentialsProviderFunc(func(ctx context.Context, accountID string) (*moysklad.Credentials, error) {
+		if accountID == "east" {
+			return &moysklad.Credentials{Token: "token", Location: loc}, nil
+		}
+		return &moysklad.Credentials{Token: "token"}, nil
+	})
+
+	pool := moysklad.NewClientPool(provider, moysklad.ClientPoolOptions{Config: moysklad.Config{BaseURL: server.URL}})
+	defer pool.Close()
+
+	ctx := context.Background()
+
+	east, err := pool.Client(ctx, "east")
+	if err != nil {
+		t.Fatal(err)
+	}
+	west, err := pool.Client(ctx, "west")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if east.Location() != loc || west.Location() != moysklad.Location() {
+		t.Fatalf("got locations %s and %s, want %s and %s", east.Location(), west.Location(), loc, moysklad.Location())
+	}
+}
+
+func TestClientPoolInvalidateSharesLimits(t *testing.T) {
+	var (
+		started = make(chan struct{})
+		unblock = make(chan struct{})
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-unblock
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"size":0},"rows":[]}`))
+	}))
+	defer server.Close()
+
+	provider := moysklad.CredentialsProviderFunc(func(ctx context.Context, accountID string) (*moysklad.Credentials, error) {
+		return &moysklad.Credentials{Token: "token"}, nil
+	})
+
+	pool := moysklad.NewClientPool(provider, moysklad.ClientPoolOptions{
+		Config: moysklad.Config{BaseURL: server.URL, MaxConcurrentRequests: 1},
+	})
+	defer pool.Close()
+
+	ctx := context.Background()
+
+	old, err := pool.Client(ctx, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error)
+	go func() {
+		_, _, err := old.Entity().Product().GetList(ctx)
+		done <- err
+	}()
+	<-started
+
+	pool.Invalidate("a")
+
+	client, err := pool.Client(ctx, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client == old {
+		t.Fatal("got the same client after invalidate")
+	}
+
+	if running := client.RateLimits().Running; running != 1 {
+		t.Fatalf("got %d running requests for new client, want 1", running)
+	}
+
+	close(unblock)
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientPoolFirstCallerCancel(t *testing.T) {
+	server, _ := authServer(t)
+
+	var (
+		requested = make(chan struct{})
+		unblock   = make(chan struct{})
+	)
+
+	provider := moysklad.CredentialsProviderFunc(func(ctx context.Context, accountID string) (*moysklad.Credentials, error) {
+		close(requested)
+		<-unblock
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return &moysklad.Credentials{Token: "token"}, nil
+	})
+
+	pool := moysklad.NewClientPool(provider, moysklad.ClientPoolOptions{Config: moysklad.Config{BaseURL: server.URL}})
+	defer pool.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	first := make(chan error)
+	go func() {
+		_, err := pool.Client(ctx, "a")
+		first <- err
+	}()
+	<-requested
+
+	second := make(chan error)
+	go func() {
+		_, err := pool.Client(context.Background(), "a")
+		second <- err
+	}()
+
+	// отмена первого вызова не прерывает создание клиента для других вызовов
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+
+	close(unblock)
+	if err := <-second; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientPoolInvalidateSharesAsyncTasks(t *testing.T) {
+	server := mstest.NewServer(mstest.WithAsyncDelay(time.Hour))
+	defer server.Close()
+
+	provider := moysklad.CredentialsProviderFunc(func(ctx context.Context, accountID string) (*moysklad.Credentials, error) {
+		return &moysklad.Credentials{Token: "token"}, nil
+	})
+
+	pool := moysklad.NewClientPool(provider, moysklad.ClientPoolOptions{Config: moysklad.Config{BaseURL: server.BaseURL()}})
+	defer pool.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	old, err := pool.Client(ctx, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tasks []moysklad.AsyncResultService[moysklad.List[moysklad.Product]]
+	for range moysklad.MaxAsyncTasks {
+		async, _, err := old.Entity().Product().GetListAsync(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tasks = append(tasks, async)
+	}
+
+	pool.Invalidate("a")
+
+	client, err := pool.Client(ctx, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// задачи клиента, удалённого из пула, занимают места в общей очереди аккаунта
+	waitCtx, waitCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer waitCancel()
+
+	if _, _, err = client.Entity().Product().GetListAsync(waitCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want context.DeadlineExceeded", err)
+	}
+
+	if _, _, err = tasks[0].Cancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	async, _, err := client.Entity().Product().GetListAsync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, task := range append(tasks[1:], async) {
+		if _, _, err = task.Cancel(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package moysklad
+
+import (
+	"context"
+	"fmt"
+)
+
+// PositionSyncStep этап синхронизации позиций документа.
+//
+// Возможные значения:
+//   - PositionSyncDelete – удаление позиций
+//   - PositionSyncUpdate – изменение позиций
+//   - PositionSyncCreate – создание позиций
+type PositionSyncStep string
+
+const (
+	PositionSyncDelete PositionSyncStep = "delete" // Удаление позиций
+	PositionSyncUpdate PositionSyncStep = "update" // Изменение позиций
+	PositionSyncCreate PositionSyncStep = "create" // Создание позиций
+)
+
+// PositionSyncResult результат синхронизации позиций документа.
+type PositionSyncResult[T any] struct {
+	Created    Slice[T]         // Созданные позиции
+	Updated    Slice[T]         // Изменённые позиции
+	Deleted    Slice[T]         // Удалённые позиции
+	Unchanged  int              // Количество позиций, которые не изменились
+	FailedStep PositionSyncStep // Этап, на котором синхронизация прервана ошибкой. Пустая строка, если ошибок не было
+}
+
+// String реализует интерфейс [fmt.Stringer].
+func (positionSyncResult PositionSyncResult[T]) String() string {
+	s := fmt.Sprintf("created: %d, updated: %d, deleted: %d, unchanged: %d",
+		positionSyncResult.Created.Len(), positionSyncResult.Updated.Len(), positionSyncResult.Deleted.Len(), positionSyncResult.Unchanged)
+	if positionSyncResult.FailedStep != "" {
+		s += ", failed step: " + string(positionSyncResult.FailedStep)
+	}
+	return s
+}
+
+// SyncPositions приводит позиции документа с ID id к желаемому состоянию desired, выполняя минимальное число изменений.
+//
+// Текущие позиции сопоставляются с желаемыми по ключу key. Если key равен nil, позиции сопоставляются
+// по ссылке на товар/услугу/серию/модификацию (assortment). Позиции с одинаковым ключом сопоставляются по порядку.
+//
+// Сопоставленные позиции изменяются, только если значения их полей отличаются от желаемых,
+// поэтому резервы и коды маркировки неизменённых позиций сохраняются. Несопоставленные желаемые позиции создаются,
+// а текущие – удаляются. Удаление выполняется первым, чтобы освободить резервы, затем позиции изменяются и создаются.
+//
+// При ошибке на одном из этапов следующие этапы не выполняются: поле FailedStep результата содержит
+// этап, завершившийся ошибкой, а результат – изменения, выполненные до ошибки.
+func (endpoint *endpointPositions[T]) SyncPositions(ctx context.Context, id string, desired Slice[T], key func(position *T) string) (*PositionSyncResult[T], error) {
+	if key == nil {
+		key = assortmentKey[T]
+	}
+
+	current, _, err := endpoint.GetPositionListAll(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make(map[string][]*T, current.Len())
+	for _, position := range *current {
+		k := key(position)
+		matches[k] = append(matches[k], position)
+	}
+
+	var (
+		result  = new(PositionSyncResult[T])
+		creates Slice[T]
+		updates = make(map[string]*T)
+		order   []string
+	)
+
+	for i, position := range desired {
+		if position == nil {
+			continue
+		}
+
+		k := key(position)
+		if k == "" {
+			return nil, fmt.Errorf("sync positions: position %d has empty key", i)
+		}
+
+		candidates := matches[k]
+		if len(candidates) == 0 {
+			creates.Push(position)
+			continue
+		}
+
+		matched := candidates[0]
+		matches[k] = candidates[1:]
+
+		if isUnchanged(position, matched) {
+			result.Unchanged++
+			continue
+		}
+
+		matchedID := positionID(matched)
+		updates[matchedID] = position
+		order = append(order, matchedID)
+	}
+
+	var deletes Slice[T]
+	for _, position := range *current {
+		if candidates := matches[key(position)]; len(candidates) > 0 && candidates[0] == position {
+			deletes.Push(position)
+			matches[key(position)] = candidates[1:]
+		}
+	}
+
+	// fail прерывает синхронизацию на этапе step
+	fail := func(step PositionSyncStep, err error) (*PositionSyncResult[T], error) {
+		result.FailedStep = step
+		return result, fmt.Errorf("sync positions: %s: %w", step, err)
+	}
+
+	if deletes.Len() > 0 {
+		if _, _, err := endpoint.DeletePositionMany(ctx, id, deletes...); err != nil {
+			return fail(PositionSyncDelete, err)
+		}
+		result.Deleted = deletes
+	}
+
+	for _, updateID := range order {
+		updated, _, err := endpoint.UpdatePosition(ctx, id, updateID, updates[updateID])
+		if err != nil {
+			return fail(PositionSyncUpdate, err)
+		}
+		result.Updated.Push(updated)
+	}
+
+	if creates.Len() > 0 {
+		bulkResult, _, err := endpoint.CreatePositionMany(ctx, id, creates...)
+		if bulkResult != nil {
+			result.Created = bulkResult.Entities()
+		}
+		if err != nil {
+			return fail(PositionSyncCreate, err)
+		}
+	}
+
+	return result, nil
+}
+
+// assortmentKey возвращает ссылку на товар/услугу/серию/модификацию позиции.
+func assortmentKey[T any](position *T) string {
+	if owner, ok := any(position).(interface{ GetAssortment() AssortmentPosition }); ok {
+		return owner.GetAssortment().GetMeta().GetHref()
+	}
+	return ""
+}
+
+// positionID возвращает ID позиции.
+func positionID(position any) string {
+	if owner, ok := position.(interface{ GetID() string }); ok {
+		return owner.GetID()
+	}
+	return ""
+}
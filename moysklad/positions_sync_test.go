@@ -0,0 +1,123 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/mstest"
+)
+
+func TestSyncPositionsSteps(t *testing.T) {
+	// matchStep возвращает true, если запрос выполняется на этапе step
+	matchStep := func(step moysklad.PositionSyncStep, request *moysklad.RequestInfo) bool {
+		switch step {
+		case moysklad.PositionSyncDelete:
+			return request.Method == http.MethodPost && strings.HasSuffix(request.Path, "/positions/delete")
+		case moysklad.PositionSyncUpdate:
+			return request.Method == http.MethodPut
+		case moysklad.PositionSyncCreate:
+			return request.Method == http.MethodPost && strings.HasSuffix(request.Path, "/positions")
+		}
+		return false
+	}
+
+	tests := []struct {
+		fail                      moysklad.PositionSyncStep
+		created, updated, deleted int
+		positions                 int     // Количество позиций документа после синхронизации
+		quantity                  float64 // Количество товара B после синхронизации
+	}{
+		{"", 1, 1, 1, 3, 3},
+		{moysklad.PositionSyncDelete, 0, 0, 0, 3, 2},
+		{moysklad.PositionSyncUpdate, 0, 0, 1, 2, 2},
+		{moysklad.PositionSyncCreate, 0, 1, 1, 2, 3},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.fail), func(t *testing.T) {
+			server := mstest.NewServer(mstest.WithRateLimit(0, 0))
+			defer server.Close()
+
+			var failing atomic.Value
+			failing.Store(moysklad.PositionSyncStep(""))
+
+			errStep := errors.New("step failed")
+			client := server.Client(moysklad.Config{
+				Token: "test",
+				Middlewares: []moysklad.Middleware{func(next moysklad.Handler) moysklad.Handler {
+					return func(ctx context.Context, request *moysklad.RequestInfo) (*moysklad.ResponseInfo, error) {
+						if step := failing.Load().(moysklad.PositionSyncStep); step != "" && matchStep(step, request) {
+							return nil, errStep
+						}
+						return next(ctx, request)
+					}
+				}},
+			})
+
+			ctx := context.Background()
+			service := client.Entity().CustomerOrder()
+
+			products := make(map[string]*moysklad.Product)
+			for _, name := range []string{"A", "B", "C", "D"} {
+				product, _, err := client.Entity().Product().Create(ctx, &moysklad.Product{Name: moysklad.String(name)})
+				if err != nil {
+					t.Fatal(err)
+				}
+				products[name] = product
+			}
+
+			order, _, err := service.Create(ctx, &moysklad.CustomerOrder{Name: moysklad.String("1")})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			position := func(name string, quantity float64) *moysklad.CustomerOrderPosition {
+				return new(moysklad.CustomerOrderPosition).SetAssortment(products[name]).SetQuantity(quantity)
+			}
+
+			if _, _, err = service.CreatePositionMany(ctx, order.GetID(), position("A", 1), position("B", 2), position("C", 1)); err != nil {
+				t.Fatal(err)
+			}
+
+			// A удаляется, B изменяется, C не изменяется, D создаётся
+			failing.Store(test.fail)
+			result, err := service.SyncPositions(ctx, order.GetID(), moysklad.Slice[moysklad.CustomerOrderPosition]{
+				position("B", 3),
+				position("C", 1),
+				position("D", 1),
+			}, nil)
+			failing.Store(moysklad.PositionSyncStep(""))
+
+			if test.fail == "" && err != nil {
+				t.Fatal(err)
+			}
+			if test.fail != "" && !errors.Is(err, errStep) {
+				t.Fatalf("got error %v, want step error", err)
+			}
+			if result.FailedStep != test.fail {
+				t.Fatalf("got failed step %q, want %q", result.FailedStep, test.fail)
+			}
+			if result.Created.Len() != test.created || result.Updated.Len() != test.updated || result.Deleted.Len() != test.deleted || result.Unchanged != 1 {
+				t.Fatalf("got sync result %s, want created %d, updated %d, deleted %d, unchanged 1", result, test.created, test.updated, test.deleted)
+			}
+
+			positions, _, err := service.GetPositionListAll(ctx, order.GetID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if positions.Len() != test.positions {
+				t.Fatalf("got %d positions, want %d", positions.Len(), test.positions)
+			}
+			for _, position := range *positions {
+				if position.GetAssortment().GetMeta().GetHref() == products["B"].GetMeta().GetHref() && position.GetQuantity() != test.quantity {
+					t.Fatalf("got quantity %v of B, want %v", position.GetQuantity(), test.quantity)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,7 +20,7 @@ type Prepayment struct {
 	Owner         *Employee                      `json:"owner,omitempty"`         // Метаданные владельца (Сотрудника)
 	Applicable    *bool                          `json:"applicable,omitempty"`    // Отметка о проведении
 	Agent         *Agent                         `json:"agent,omitempty"`         // Метаданные контрагента
-	CashSum       *float64                       `json:"cashSum,omitempty"`       // Оплачено наличными
+	CashSum       *Money                         `json:"cashSum,omitempty"`       // Оплачено наличными
 	Code          *string                        `json:"code,omitempty"`          // Код Предоплаты
 	Created       *Timestamp                     `json:"created,omitempty"`       // Дата создания
 	CustomerOrder *CustomerOrder                 `json:"customerOrder,omitempty"` // Метаданные Заказа Покупателя
@@ -32,22 +33,22 @@ type Prepayment struct {
 	Meta          *Meta                          `json:"meta,omitempty"`          // Метаданные Предоплаты
 	Moment        *Timestamp                     `json:"moment,omitempty"`        // Дата документа
 	Name          *string                        `json:"name,omitempty"`          // Наименование Предоплаты
-	NoCashSum     *float64                       `json:"noCashSum,omitempty"`     // Оплачено картой
+	NoCashSum     *Money                         `json:"noCashSum,omitempty"`     // Оплачено картой
 	AccountID     *string                        `json:"accountId,omitempty"`     // ID учётной записи
 	VatIncluded   *bool                          `json:"vatIncluded,omitempty"`   // Включен ли НДС в цену
 	Positions     *MetaArray[PrepaymentPosition] `json:"positions,omitempty"`     // Метаданные позиций Предоплаты
 	Printed       *bool                          `json:"printed,omitempty"`       // Напечатан ли документ
 	Published     *bool                          `json:"published,omitempty"`     // Опубликован ли документ
-	QRSum         *float64                       `json:"qrSum,omitempty"`         // Оплачено по QR-коду
+	QRSum         *Money                         `json:"qrSum,omitempty"`         // Оплачено по QR-коду
 	Rate          *NullValue[Rate]               `json:"rate,omitempty"`          // Валюта
 	RetailShift   *RetailShift                   `json:"retailShift,omitempty"`   // Метаданные Розничной смены
 	RetailStore   *RetailStore                   `json:"retailStore,omitempty"`   // Метаданные Точки продаж
 	Organization  *Organization                  `json:"organization,omitempty"`  // Метаданные юрлица
 	Shared        *bool                          `json:"shared,omitempty"`        // Общий доступ
 	State         *State                         `json:"state,omitempty"`         // Метаданные статуса Предоплаты
-	Sum           *float64                       `json:"sum,omitempty"`           // Сумма Предоплаты в копейках
+	Sum           *Money                         `json:"sum,omitempty"`           // Сумма Предоплаты в копейках
 	SyncID        *string                        `json:"syncId,omitempty"`        // ID синхронизации
-	VatSum        *float64                       `json:"vatSum,omitempty"`        // Сумма НДС
+	VatSum        *Money                         `json:"vatSum,omitempty"`        // Сумма НДС
 	Updated       *Timestamp                     `json:"updated,omitempty"`       // Момент последнего обновления Предоплаты
 	VatEnabled    *bool                          `json:"vatEnabled,omitempty"`    // Учитывается ли НДС
 	TaxSystem     TaxSystem                      `json:"taxSystem,omitempty"`     // Код системы налогообложения
@@ -91,7 +92,7 @@ func (prepayment Prepayment) GetAgent() Agent {
 
 // GetCashSum возвращает Оплачено наличными.
 func (prepayment Prepayment) GetCashSum() float64 {
-	return Deref(prepayment.CashSum)
+	return Deref(prepayment.CashSum).Float()
 }
 
 // GetCode возвращает Код Предоплаты.
@@ -156,7 +157,7 @@ func (prepayment Prepayment) GetName() string {
 
 // GetNoCashSum возвращает Оплачено картой.
 func (prepayment Prepayment) GetNoCashSum() float64 {
-	return Deref(prepayment.NoCashSum)
+	return Deref(prepayment.NoCashSum).Float()
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -186,7 +187,7 @@ func (prepayment Prepayment) GetPublished() bool {
 
 // GetQRSum возвращает оплачено по QR-коду.
 func (prepayment Prepayment) GetQRSum() float64 {
-	return Deref(prepayment.QRSum)
+	return Deref(prepayment.QRSum).Float()
 }
 
 // GetRate возвращает Валюту.
@@ -221,7 +222,7 @@ func (prepayment Prepayment) GetState() State {
 
 // GetSum возвращает Сумму Перемещения в копейках.
 func (prepayment Prepayment) GetSum() float64 {
-	return Deref(prepayment.Sum)
+	return Deref(prepayment.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -231,7 +232,7 @@ func (prepayment Prepayment) GetSyncID() string {
 
 // GetVatSum возвращает Сумму НДС.
 func (prepayment Prepayment) GetVatSum() float64 {
-	return Deref(prepayment.VatSum)
+	return Deref(prepayment.VatSum).Float()
 }
 
 // GetUpdated возвращает Момент последнего обновления Предоплаты.
@@ -277,7 +278,7 @@ type PrepaymentPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
@@ -312,7 +313,7 @@ func (prepaymentPosition PrepaymentPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (prepaymentPosition PrepaymentPosition) GetPrice() float64 {
-	return Deref(prepaymentPosition.Price)
+	return Deref(prepaymentPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в позиции.
@@ -361,6 +362,21 @@ type PrepaymentService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Prepayment], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех предоплат.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Prepayment, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех предоплат.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Prepayment]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех предоплат асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Prepayment, error]
+
 	// DeleteByID выполняет запрос на удаление предоплаты по ID.
 	// Принимает контекст и ID предоплаты.
 	// Возвращает «true» в случае успешного удаления предоплаты.
@@ -388,6 +404,11 @@ type PrepaymentService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PrepaymentPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PrepaymentPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -411,8 +432,8 @@ type PrepaymentService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -426,8 +447,8 @@ type PrepaymentService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetBySyncID выполняет запрос на получение отдельного документа по syncID.
 	// Принимает контекст и syncID документа.
@@ -461,8 +482,8 @@ type PrepaymentService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,7 +20,7 @@ type PrepaymentReturn struct {
 	Organization *Organization                        `json:"organization,omitempty"` // Метаданные юрлица
 	Applicable   *bool                                `json:"applicable,omitempty"`   // Отметка о проведении
 	AccountID    *string                              `json:"accountId,omitempty"`    // ID учётной записи
-	CashSum      *float64                             `json:"cashSum,omitempty"`      // Оплачено наличными
+	CashSum      *Money                               `json:"cashSum,omitempty"`      // Оплачено наличными
 	Code         *string                              `json:"code,omitempty"`         // Код Возврата предоплаты
 	Created      *Timestamp                           `json:"created,omitempty"`      // Дата создания
 	Deleted      *Timestamp                           `json:"deleted,omitempty"`      // Момент последнего удаления Возврата предоплаты
@@ -31,22 +32,22 @@ type PrepaymentReturn struct {
 	Meta         *Meta                                `json:"meta,omitempty"`         // Метаданные Возврата предоплаты
 	Moment       *Timestamp                           `json:"moment,omitempty"`       // Дата документа
 	Name         *string                              `json:"name,omitempty"`         // Наименование Возврата предоплаты
-	NoCashSum    *float64                             `json:"noCashSum,omitempty"`    // Оплачено картой
+	NoCashSum    *Money                               `json:"noCashSum,omitempty"`    // Оплачено картой
 	Owner        *Employee                            `json:"owner,omitempty"`        // Метаданные владельца (Сотрудника)
 	VatIncluded  *bool                                `json:"vatIncluded,omitempty"`  // Включен ли НДС в цену
 	Positions    *MetaArray[PrepaymentReturnPosition] `json:"positions,omitempty"`    // Метаданные позиций Возврата предоплаты
 	Prepayment   *Prepayment                          `json:"prepayment,omitempty"`   // Метаданные Предоплаты
 	Printed      *bool                                `json:"printed,omitempty"`      // Напечатан ли документ
 	Published    *bool                                `json:"published,omitempty"`    // Опубликован ли документ
-	QRSum        *float64                             `json:"qrSum,omitempty"`        // Оплачено по QR-коду
+	QRSum        *Money                               `json:"qrSum,omitempty"`        // Оплачено по QR-коду
 	Rate         *NullValue[Rate]                     `json:"rate,omitempty"`         // Валюта
 	RetailShift  *RetailShift                         `json:"retailShift,omitempty"`  // Метаданные Розничной смены
 	RetailStore  *RetailStore                         `json:"retailStore,omitempty"`  // Метаданные Точки продаж
 	Shared       *bool                                `json:"shared,omitempty"`       // Общий доступ
 	State        *State                               `json:"state,omitempty"`        // Метаданные статуса Возврата предоплаты
-	Sum          *float64                             `json:"sum,omitempty"`          // Сумма Возврата предоплаты в копейках
+	Sum          *Money                               `json:"sum,omitempty"`          // Сумма Возврата предоплаты в копейках
 	SyncID       *string                              `json:"syncId,omitempty"`       // ID синхронизации
-	VatSum       *float64                             `json:"vatSum,omitempty"`       // Сумма НДС
+	VatSum       *Money                               `json:"vatSum,omitempty"`       // Сумма НДС
 	Updated      *Timestamp                           `json:"updated,omitempty"`      // Момент последнего обновления Возврата предоплаты
 	VatEnabled   *bool                                `json:"vatEnabled,omitempty"`   // Учитывается ли НДС
 	TaxSystem    TaxSystem                            `json:"taxSystem,omitempty"`    // Код системы налогообложения
@@ -90,7 +91,7 @@ func (prepaymentReturn PrepaymentReturn) GetAccountID() string {
 
 // GetCashSum возвращает Оплачено наличными.
 func (prepaymentReturn PrepaymentReturn) GetCashSum() float64 {
-	return Deref(prepaymentReturn.CashSum)
+	return Deref(prepaymentReturn.CashSum).Float()
 }
 
 // GetCode возвращает Код Возврата предоплаты.
@@ -150,7 +151,7 @@ func (prepaymentReturn PrepaymentReturn) GetName() string {
 
 // GetNoCashSum возвращает Оплачено картой.
 func (prepaymentReturn PrepaymentReturn) GetNoCashSum() float64 {
-	return Deref(prepaymentReturn.NoCashSum)
+	return Deref(prepaymentReturn.NoCashSum).Float()
 }
 
 // GetOwner возвращает Метаданные владельца (Сотрудника).
@@ -185,7 +186,7 @@ func (prepaymentReturn PrepaymentReturn) GetPublished() bool {
 
 // GetQRSum возвращает оплачено по QR-коду.
 func (prepaymentReturn PrepaymentReturn) GetQRSum() float64 {
-	return Deref(prepaymentReturn.QRSum)
+	return Deref(prepaymentReturn.QRSum).Float()
 }
 
 // GetRate возвращает Валюту.
@@ -215,7 +216,7 @@ func (prepaymentReturn PrepaymentReturn) GetState() State {
 
 // GetSum возвращает Сумму Возврата предоплаты в копейках.
 func (prepaymentReturn PrepaymentReturn) GetSum() float64 {
-	return Deref(prepaymentReturn.Sum)
+	return Deref(prepaymentReturn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -225,7 +226,7 @@ func (prepaymentReturn PrepaymentReturn) GetSyncID() string {
 
 // GetVatSum возвращает Сумму НДС.
 func (prepaymentReturn PrepaymentReturn) GetVatSum() float64 {
-	return Deref(prepaymentReturn.VatSum)
+	return Deref(prepaymentReturn.VatSum).Float()
 }
 
 // GetUpdated возвращает Момент последнего обновления Возврата предоплаты.
@@ -271,7 +272,7 @@ type PrepaymentReturnPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
@@ -306,7 +307,7 @@ func (prepaymentReturnPosition PrepaymentReturnPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (prepaymentReturnPosition PrepaymentReturnPosition) GetPrice() float64 {
-	return Deref(prepaymentReturnPosition.Price)
+	return Deref(prepaymentReturnPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -350,6 +351,21 @@ type PrepaymentReturnService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PrepaymentReturn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех возвратов предоплат.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PrepaymentReturn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех возвратов предоплат.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[PrepaymentReturn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех возвратов предоплат асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*PrepaymentReturn, error]
+
 	// GetByID выполняет запрос на получение отдельного возврата предоплаты по ID.
 	// Принимает контекст, ID возврата предоплаты и опционально объект параметров запроса Params.
 	// Возвращает найденный возврат предоплаты.
@@ -373,8 +389,8 @@ type PrepaymentReturnService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -388,8 +404,8 @@ type PrepaymentReturnService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetMetadata выполняет запрос на получение метаданных возвратов предоплаты.
 	// Принимает контекст.
@@ -403,6 +419,11 @@ type PrepaymentReturnService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PrepaymentReturnPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PrepaymentReturnPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -440,8 +461,8 @@ type PrepaymentReturnService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -331,8 +332,8 @@ func (priceList *PriceList) Delete(ctx context.Context, client *Client) (bool, *
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-prajs-list-prajs-listy-yachejki
 type PriceListCell struct {
-	Column *string  `json:"column,omitempty"` // Название столбца, к которому относится данная ячейка
-	Sum    *float64 `json:"sum,omitempty"`    // Числовое значение ячейки
+	Column *string `json:"column,omitempty"` // Название столбца, к которому относится данная ячейка
+	Sum    *Money  `json:"sum,omitempty"`    // Числовое значение ячейки
 }
 
 // GetColumn возвращает Название столбца, к которому относится данная ячейка.
@@ -342,7 +343,7 @@ func (priceListCell PriceListCell) GetColumn() string {
 
 // GetSum возвращает Числовое значение ячейки.
 func (priceListCell PriceListCell) GetSum() float64 {
-	return Deref(priceListCell.Sum)
+	return Deref(priceListCell.Sum).Float()
 }
 
 // SetColumn устанавливает Название столбца, к которому относится данная ячейка.
@@ -353,7 +354,7 @@ func (priceListCell *PriceListCell) SetColumn(column string) *PriceListCell {
 
 // SetSum устанавливает Числовое значение ячейки.
 func (priceListCell *PriceListCell) SetSum(sum float64) *PriceListCell {
-	priceListCell.Sum = &sum
+	priceListCell.Sum = NewMoney(MoneyFromFloat(sum))
 	return priceListCell
 }
 
@@ -487,6 +488,21 @@ type PriceListService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PriceList], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех прайс-листов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PriceList, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех прайс-листов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[PriceList]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех прайс-листов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*PriceList, error]
+
 	// Create выполняет запрос на создание прайс-листа.
 	// Обязательные поля для заполнения:
 	//	- columns (Массив объектов, описывающих столбцы нового прайс-листа)
@@ -497,13 +513,13 @@ type PriceListService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение прайс-листа.
 	// Изменяемые прайс-листы должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список прайс-листов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых прайс-листов.
-	CreateUpdateMany(ctx context.Context, priceListList Slice[PriceList], params ...func(*Params)) (*Slice[PriceList], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых прайс-листов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, priceListList Slice[PriceList], params ...func(*Params)) (*BulkResult[PriceList], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление прайс-листов.
 	// Принимает контекст и множество прайс-листов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*PriceList) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*PriceList) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление прайс-листа по ID.
 	// Принимает контекст и ID прайс-листа.
@@ -537,6 +553,11 @@ type PriceListService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PriceListPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PriceListPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -554,8 +575,8 @@ type PriceListService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*PriceListPosition) (*Slice[PriceListPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*PriceListPosition) (*BulkResult[PriceListPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -567,6 +588,12 @@ type PriceListService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*PriceListPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[PriceListPosition], key func(position *PriceListPosition) string) (*PositionSyncResult[PriceListPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -600,8 +627,8 @@ type PriceListService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -615,8 +642,8 @@ type PriceListService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetBySyncID выполняет запрос на получение отдельного документа по syncID.
 	// Принимает контекст и syncID документа.
@@ -650,8 +677,8 @@ type PriceListService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
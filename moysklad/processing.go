@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -36,7 +37,7 @@ type Processing struct {
 	Owner               *Employee                         `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
 	Printed             *bool                             `json:"printed,omitempty"`             // Напечатан ли документ
 	ProcessingPlan      *ProcessingPlan                   `json:"processingPlan,omitempty"`      // Метаданные Техкарты
-	ProcessingSum       *float64                          `json:"processingSum,omitempty"`       // Затраты на производство за единицу объема производства
+	ProcessingSum       *Money                            `json:"processingSum,omitempty"`       // Затраты на производство за единицу объема производства
 	Updated             *Timestamp                        `json:"updated,omitempty"`             // Момент последнего обновления Техоперации
 	ProductsStore       *Store                            `json:"productsStore,omitempty"`       // Метаданные склада для продукции
 	Project             *NullValue[Project]               `json:"project,omitempty"`             // Метаданные проекта
@@ -167,7 +168,7 @@ func (processing Processing) GetProcessingPlan() ProcessingPlan {
 
 // GetProcessingSum возвращает Затраты на производство за единицу объема производства.
 func (processing Processing) GetProcessingSum() float64 {
-	return Deref(processing.ProcessingSum)
+	return Deref(processing.ProcessingSum).Float()
 }
 
 // GetUpdated возвращает Момент последнего обновления Техоперации.
@@ -331,7 +332,7 @@ func (processing *Processing) SetProcessingPlan(processingPlan *ProcessingPlan)
 
 // SetProcessingSum устанавливает Затраты на производство за единицу объема производства.
 func (processing *Processing) SetProcessingSum(processingSum float64) *Processing {
-	processing.ProcessingSum = &processingSum
+	processing.ProcessingSum = NewMoney(MoneyFromFloat(processingSum))
 	return processing
 }
 
@@ -558,6 +559,21 @@ type ProcessingService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Processing], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех техопераций.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Processing, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех техопераций.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Processing]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех техопераций асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Processing, error]
+
 	// Create выполняет запрос на создание техоперации.
 	// Обязательные для создания поля с привязкой техкарты:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -580,13 +596,13 @@ type ProcessingService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение техопераций.
 	// Изменяемые техоперации должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список техопераций и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых техопераций.
-	CreateUpdateMany(ctx context.Context, processingList Slice[Processing], params ...func(*Params)) (*Slice[Processing], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых техопераций и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, processingList Slice[Processing], params ...func(*Params)) (*BulkResult[Processing], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление техопераций.
 	// Принимает контекст и множество техопераций.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Processing) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Processing) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление техоперации по ID.
 	// Принимает контекст и ID техоперации.
@@ -644,8 +660,8 @@ type ProcessingService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -659,8 +675,8 @@ type ProcessingService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetBySyncID выполняет запрос на получение отдельного документа по syncID.
 	// Принимает контекст и syncID документа.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -427,8 +428,8 @@ const (
 type ProcessingPlanService interface {
 	GetList(ctx context.Context, params ...func(*Params)) (*List[ProcessingPlan], *resty.Response, error)
 	Create(ctx context.Context, processingPlan *ProcessingPlan, params ...func(*Params)) (*ProcessingPlan, *resty.Response, error)
-	CreateUpdateMany(ctx context.Context, processingPlanList Slice[ProcessingPlan], params ...func(*Params)) (*Slice[ProcessingPlan], *resty.Response, error)
-	DeleteMany(ctx context.Context, entities ...*ProcessingPlan) (*DeleteManyResponse, *resty.Response, error)
+	CreateUpdateMany(ctx context.Context, processingPlanList Slice[ProcessingPlan], params ...func(*Params)) (*BulkResult[ProcessingPlan], *resty.Response, error)
+	DeleteMany(ctx context.Context, entities ...*ProcessingPlan) (*BulkResult[DeleteManyRow], *resty.Response, error)
 	DeleteByID(ctx context.Context, id string) (bool, *resty.Response, error)
 
 	// Delete выполняет запрос на удаление техкарты.
@@ -445,6 +446,11 @@ type ProcessingPlanService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[ProcessingPlanProduct], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*ProcessingPlanProduct, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -462,8 +468,8 @@ type ProcessingPlanService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*ProcessingPlanProduct) (*Slice[ProcessingPlanProduct], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*ProcessingPlanProduct) (*BulkResult[ProcessingPlanProduct], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -475,6 +481,12 @@ type ProcessingPlanService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*ProcessingPlanProduct) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[ProcessingPlanProduct], key func(position *ProcessingPlanProduct) string) (*PositionSyncResult[ProcessingPlanProduct], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
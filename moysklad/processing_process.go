@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -225,8 +226,8 @@ func (ProcessingProcessPosition) MetaType() MetaType {
 type ProcessingProcessService interface {
 	GetList(ctx context.Context, params ...func(*Params)) (*List[ProcessingProcess], *resty.Response, error)
 	Create(ctx context.Context, processingProcess *ProcessingProcess, params ...func(*Params)) (*ProcessingProcess, *resty.Response, error)
-	CreateUpdateMany(ctx context.Context, processingProcessList Slice[ProcessingProcess], params ...func(*Params)) (*Slice[ProcessingProcess], *resty.Response, error)
-	DeleteMany(ctx context.Context, entities ...*ProcessingProcess) (*DeleteManyResponse, *resty.Response, error)
+	CreateUpdateMany(ctx context.Context, processingProcessList Slice[ProcessingProcess], params ...func(*Params)) (*BulkResult[ProcessingProcess], *resty.Response, error)
+	DeleteMany(ctx context.Context, entities ...*ProcessingProcess) (*BulkResult[DeleteManyRow], *resty.Response, error)
 	DeleteByID(ctx context.Context, id string) (bool, *resty.Response, error)
 
 	// Delete выполняет запрос на удаление тех процесса.
@@ -243,6 +244,11 @@ type ProcessingProcessService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[ProcessingProcessPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*ProcessingProcessPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -260,8 +266,8 @@ type ProcessingProcessService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*ProcessingProcessPosition) (*Slice[ProcessingProcessPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*ProcessingProcessPosition) (*BulkResult[ProcessingProcessPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -273,6 +279,12 @@ type ProcessingProcessService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*ProcessingProcessPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[ProcessingProcessPosition], key func(position *ProcessingProcessPosition) string) (*PositionSyncResult[ProcessingProcessPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
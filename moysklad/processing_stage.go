@@ -171,8 +171,8 @@ func (processingStage *ProcessingStage) Delete(ctx context.Context, client *Clie
 type ProcessingStageService interface {
 	GetList(ctx context.Context, params ...func(*Params)) (*List[ProcessingStage], *resty.Response, error)
 	Create(ctx context.Context, processingStage *ProcessingStage, params ...func(*Params)) (*ProcessingStage, *resty.Response, error)
-	CreateUpdateMany(ctx context.Context, processingStageList Slice[ProcessingStage], params ...func(*Params)) (*Slice[ProcessingStage], *resty.Response, error)
-	DeleteMany(ctx context.Context, entities ...*ProcessingStage) (*DeleteManyResponse, *resty.Response, error)
+	CreateUpdateMany(ctx context.Context, processingStageList Slice[ProcessingStage], params ...func(*Params)) (*BulkResult[ProcessingStage], *resty.Response, error)
+	DeleteMany(ctx context.Context, entities ...*ProcessingStage) (*BulkResult[DeleteManyRow], *resty.Response, error)
 	DeleteByID(ctx context.Context, id string) (bool, *resty.Response, error)
 
 	// Delete выполняет запрос на удаление этапа производства.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -762,6 +763,21 @@ type ProductService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Product], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех товаров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Product, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех товаров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Product]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех товаров асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Product, error]
+
 	// Create выполняет запрос на создание товара.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование товара)
@@ -772,13 +788,13 @@ type ProductService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение товаров.
 	// Изменяемые товары должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список товаров и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых товаров.
-	CreateUpdateMany(ctx context.Context, productList Slice[Product], params ...func(*Params)) (*Slice[Product], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых товаров и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, productList Slice[Product], params ...func(*Params)) (*BulkResult[Product], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление товаров.
 	// Принимает контекст и множество товаров.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Product) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Product) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление товара по ID.
 	// Принимает контекст и ID товара.
@@ -823,8 +839,8 @@ type ProductService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -838,8 +854,8 @@ type ProductService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetImageList выполняет запрос на получение изображений товара в виде списка.
 	// Принимает контекст и ID товара.
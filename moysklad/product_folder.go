@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -294,6 +295,21 @@ type ProductFolderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[ProductFolder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех групп товаров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProductFolder, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех групп товаров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[ProductFolder]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех групп товаров асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProductFolder, error]
+
 	// Create выполняет запрос на создание группы товаров.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование группы товаров)
@@ -304,13 +320,13 @@ type ProductFolderService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение групп товаров.
 	// Изменяемые группы товаров должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список групп товаров и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых групп товаров.
-	CreateUpdateMany(ctx context.Context, productFolderList Slice[ProductFolder], params ...func(*Params)) (*Slice[ProductFolder], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых групп товаров и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, productFolderList Slice[ProductFolder], params ...func(*Params)) (*BulkResult[ProductFolder], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление группы товаров.
 	// Принимает контекст и множество групп товаров.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*ProductFolder) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*ProductFolder) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление группы товаров по ID.
 	// Принимает контекст и ID группы товаров.
@@ -355,8 +371,8 @@ type ProductFolderService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -370,8 +386,8 @@ type ProductFolderService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 }
 
 const (
@@ -347,8 +347,8 @@ func (ProductionStageCompletionResult) MetaType() MetaType {
 type ProductionStageCompletionService interface {
 	GetList(ctx context.Context, params ...func(*Params)) (*List[ProductionStageCompletion], *resty.Response, error)
 	Create(ctx context.Context, productionStageCompletion *ProductionStageCompletion, params ...func(*Params)) (*ProductionStageCompletion, *resty.Response, error)
-	CreateUpdateMany(ctx context.Context, productionStageCompletionList Slice[ProductionStageCompletion], params ...func(*Params)) (*Slice[ProductionStageCompletion], *resty.Response, error)
-	DeleteMany(ctx context.Context, entities ...*ProductionStageCompletion) (*DeleteManyResponse, *resty.Response, error)
+	CreateUpdateMany(ctx context.Context, productionStageCompletionList Slice[ProductionStageCompletion], params ...func(*Params)) (*BulkResult[ProductionStageCompletion], *resty.Response, error)
+	DeleteMany(ctx context.Context, entities ...*ProductionStageCompletion) (*BulkResult[DeleteManyRow], *resty.Response, error)
 	DeleteByID(ctx context.Context, id string) (bool, *resty.Response, error)
 
 	// Delete выполняет запрос на удаление выполнения этапов производства.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -208,6 +209,21 @@ type ProjectService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Project], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех проектов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Project, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех проектов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Project]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех проектов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Project, error]
+
 	// Create выполняет запрос на создание проекта.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование проекта)
@@ -218,13 +234,13 @@ type ProjectService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение проектов.
 	// Изменяемые проекты должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список проектов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых проектов.
-	CreateUpdateMany(ctx context.Context, projectList Slice[Project], params ...func(*Params)) (*Slice[Project], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых проектов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, projectList Slice[Project], params ...func(*Params)) (*BulkResult[Project], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление проектов.
 	// Принимает контекст и множество проектов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Project) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Project) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление проекта по ID.
 	// Принимает контекст и ID проекта.
@@ -269,8 +285,8 @@ type ProjectService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -284,8 +300,8 @@ type ProjectService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetNamedFilterList выполняет запрос на получение списка фильтров.
 	// Принимает контекст и опционально объект параметров запроса Params.
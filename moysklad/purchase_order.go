@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -15,7 +16,7 @@ import (
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-zakaz-postawschiku
 type PurchaseOrder struct {
-	PayedSum              *float64                          `json:"payedSum,omitempty"`              // Сумма входящих платежей по Заказу
+	PayedSum              *Money                            `json:"payedSum,omitempty"`              // Сумма входящих платежей по Заказу
 	Applicable            *bool                             `json:"applicable,omitempty"`            // Отметка о проведении
 	AgentAccount          *AgentAccount                     `json:"agentAccount,omitempty"`          // Метаданные счета контрагента
 	Owner                 *Employee                         `json:"owner,omitempty"`                 // Метаданные владельца (Сотрудника)
@@ -30,7 +31,7 @@ type PurchaseOrder struct {
 	AccountID             *string                           `json:"accountId,omitempty"`             // ID учётной записи
 	Group                 *Group                            `json:"group,omitempty"`                 // Отдел сотрудника
 	ID                    *string                           `json:"id,omitempty"`                    // ID Заказа поставщику
-	InvoicedSum           *float64                          `json:"invoicedSum,omitempty"`           // Сумма счетов поставщику
+	InvoicedSum           *Money                            `json:"invoicedSum,omitempty"`           // Сумма счетов поставщику
 	Meta                  *Meta                             `json:"meta,omitempty"`                  // Метаданные Заказа поставщику
 	Moment                *Timestamp                        `json:"moment,omitempty"`                // Дата документа
 	Name                  *string                           `json:"name,omitempty"`                  // Наименование Заказа поставщику
@@ -44,16 +45,16 @@ type PurchaseOrder struct {
 	Published             *bool                             `json:"published,omitempty"`             // Опубликован ли документ
 	Rate                  *NullValue[Rate]                  `json:"rate,omitempty"`                  // Валюта
 	Shared                *bool                             `json:"shared,omitempty"`                // Общий доступ
-	ShippedSum            *float64                          `json:"shippedSum,omitempty"`            // Сумма принятого
+	ShippedSum            *Money                            `json:"shippedSum,omitempty"`            // Сумма принятого
 	State                 *NullValue[State]                 `json:"state,omitempty"`                 // Метаданные статуса заказа поставщику
 	Store                 *NullValue[Store]                 `json:"store,omitempty"`                 // Метаданные склада
-	Sum                   *float64                          `json:"sum,omitempty"`                   // Сумма Заказа поставщику в установленной валюте
+	Sum                   *Money                            `json:"sum,omitempty"`                   // Сумма Заказа поставщику в установленной валюте
 	SyncID                *string                           `json:"syncId,omitempty"`                // ID синхронизации
 	Updated               *Timestamp                        `json:"updated,omitempty"`               // Момент последнего обновления Заказа поставщику
 	VatEnabled            *bool                             `json:"vatEnabled,omitempty"`            // Учитывается ли НДС
 	VatIncluded           *bool                             `json:"vatIncluded,omitempty"`           // Включен ли НДС в цену
-	VatSum                *float64                          `json:"vatSum,omitempty"`                // Сумма НДС
-	WaitSum               *float64                          `json:"waitSum,omitempty"`               // Сумма товаров в пути
+	VatSum                *Money                            `json:"vatSum,omitempty"`                // Сумма НДС
+	WaitSum               *Money                            `json:"waitSum,omitempty"`               // Сумма товаров в пути
 	CustomerOrders        Slice[CustomerOrder]              `json:"customerOrders,omitempty"`        // Массив ссылок на связанные заказы покупателей
 	InvoicesIn            Slice[InvoiceIn]                  `json:"invoicesIn,omitempty"`            // Массив ссылок на связанные счета поставщиков
 	Payments              Slice[Payment]                    `json:"payments,omitempty"`              // Массив ссылок на связанные платежи
@@ -88,7 +89,7 @@ func (purchaseOrder PurchaseOrder) AsOperationOut() *Operation {
 
 // GetPayedSum возвращает Сумму входящих платежей по Заказу.
 func (purchaseOrder PurchaseOrder) GetPayedSum() float64 {
-	return Deref(purchaseOrder.PayedSum)
+	return Deref(purchaseOrder.PayedSum).Float()
 }
 
 // GetApplicable возвращает Отметку о проведении.
@@ -163,7 +164,7 @@ func (purchaseOrder PurchaseOrder) GetID() string {
 
 // GetInvoicedSum возвращает Сумму счетов поставщику.
 func (purchaseOrder PurchaseOrder) GetInvoicedSum() float64 {
-	return Deref(purchaseOrder.InvoicedSum)
+	return Deref(purchaseOrder.InvoicedSum).Float()
 }
 
 // GetMeta возвращает Метаданные Заказа поставщику.
@@ -233,7 +234,7 @@ func (purchaseOrder PurchaseOrder) GetShared() bool {
 
 // GetShippedSum возвращает Сумму принятого.
 func (purchaseOrder PurchaseOrder) GetShippedSum() float64 {
-	return Deref(purchaseOrder.ShippedSum)
+	return Deref(purchaseOrder.ShippedSum).Float()
 }
 
 // GetState возвращает Метаданные статуса заказа поставщику.
@@ -248,7 +249,7 @@ func (purchaseOrder PurchaseOrder) GetStore() Store {
 
 // GetSum возвращает Сумму Заказа поставщику в установленной валюте.
 func (purchaseOrder PurchaseOrder) GetSum() float64 {
-	return Deref(purchaseOrder.Sum)
+	return Deref(purchaseOrder.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -273,12 +274,12 @@ func (purchaseOrder PurchaseOrder) GetVatIncluded() bool {
 
 // GetVatSum возвращает Сумму НДС.
 func (purchaseOrder PurchaseOrder) GetVatSum() float64 {
-	return Deref(purchaseOrder.VatSum)
+	return Deref(purchaseOrder.VatSum).Float()
 }
 
 // GetWaitSum возвращает Сумму товаров в пути.
 func (purchaseOrder PurchaseOrder) GetWaitSum() float64 {
-	return Deref(purchaseOrder.WaitSum)
+	return Deref(purchaseOrder.WaitSum).Float()
 }
 
 // GetCustomerOrders возвращает Массив ссылок на связанные заказы покупателей.
@@ -570,7 +571,7 @@ type PurchaseOrderPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Shipped    *float64            `json:"shipped,omitempty"`    // Принято
 	InTransit  *float64            `json:"inTransit,omitempty"`  // Ожидание
@@ -609,7 +610,7 @@ func (purchaseOrderPosition PurchaseOrderPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (purchaseOrderPosition PurchaseOrderPosition) GetPrice() float64 {
-	return Deref(purchaseOrderPosition.Price)
+	return Deref(purchaseOrderPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -673,7 +674,7 @@ func (purchaseOrderPosition *PurchaseOrderPosition) SetPack(pack *Pack) *Purchas
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (purchaseOrderPosition *PurchaseOrderPosition) SetPrice(price float64) *PurchaseOrderPosition {
-	purchaseOrderPosition.Price = &price
+	purchaseOrderPosition.Price = NewMoney(MoneyFromFloat(price))
 	return purchaseOrderPosition
 }
 
@@ -735,6 +736,21 @@ type PurchaseOrderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PurchaseOrder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех заказов поставщику.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PurchaseOrder, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех заказов поставщику.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[PurchaseOrder]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех заказов поставщику асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*PurchaseOrder, error]
+
 	// Create выполняет запрос на создание заказа поставщику.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -746,13 +762,13 @@ type PurchaseOrderService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение заказов поставщику.
 	// Изменяемые заказы поставщику должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список заказов поставщику и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых заказов поставщику.
-	CreateUpdateMany(ctx context.Context, purchaseOrderList Slice[PurchaseOrder], params ...func(*Params)) (*Slice[PurchaseOrder], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых заказов поставщику и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, purchaseOrderList Slice[PurchaseOrder], params ...func(*Params)) (*BulkResult[PurchaseOrder], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление заказов поставщику.
 	// Принимает контекст и множество заказов поставщику.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*PurchaseOrder) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*PurchaseOrder) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление заказа поставщику по ID.
 	// Принимает контекст и ID заказа поставщику.
@@ -799,6 +815,11 @@ type PurchaseOrderService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PurchaseOrderPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PurchaseOrderPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -816,8 +837,8 @@ type PurchaseOrderService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*PurchaseOrderPosition) (*Slice[PurchaseOrderPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*PurchaseOrderPosition) (*BulkResult[PurchaseOrderPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -829,6 +850,12 @@ type PurchaseOrderService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*PurchaseOrderPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[PurchaseOrderPosition], key func(position *PurchaseOrderPosition) string) (*PositionSyncResult[PurchaseOrderPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -862,8 +889,8 @@ type PurchaseOrderService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -877,8 +904,8 @@ type PurchaseOrderService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -942,8 +969,8 @@ type PurchaseOrderService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -42,17 +43,17 @@ type PurchaseReturn struct {
 	Shared              *bool                              `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]                  `json:"state,omitempty"`               // Метаданные статуса Возврата поставщику
 	Store               *Store                             `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                           `json:"sum,omitempty"`                 // Сумма Возврата поставщику в копейках
+	Sum                 *Money                             `json:"sum,omitempty"`                 // Сумма Возврата поставщику в копейках
 	SyncID              *string                            `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp                         `json:"updated,omitempty"`             // Момент последнего обновления Возврата поставщику
 	VatEnabled          *bool                              `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
 	VatIncluded         *bool                              `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	VatSum              *float64                           `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Money                             `json:"vatSum,omitempty"`              // Сумма НДС
 	Positions           *MetaArray[PurchaseReturnPosition] `json:"positions,omitempty"`           // Ссылка на позиции Возврата поставщику
 	Owner               *Employee                          `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
 	FactureIn           *FactureIn                         `json:"factureIn,omitempty"`           // Ссылка на Счет-фактуру полученный
 	FactureOut          *FactureOut                        `json:"factureOut,omitempty"`          // Ссылка на Счет-фактуру выданный
-	PayedSum            *float64                           `json:"payedSum,omitempty"`            // Сумма входящих платежей по возврату поставщику
+	PayedSum            *Money                             `json:"payedSum,omitempty"`            // Сумма входящих платежей по возврату поставщику
 	Attributes          Slice[Attribute]                   `json:"attributes,omitempty"`          // Список метаданных доп. полей
 }
 
@@ -218,7 +219,7 @@ func (purchaseReturn PurchaseReturn) GetStore() Store {
 
 // GetSum возвращает Сумму Возврата поставщику в копейках.
 func (purchaseReturn PurchaseReturn) GetSum() float64 {
-	return Deref(purchaseReturn.Sum)
+	return Deref(purchaseReturn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -243,7 +244,7 @@ func (purchaseReturn PurchaseReturn) GetVatIncluded() bool {
 
 // GetVatSum возвращает Сумму НДС.
 func (purchaseReturn PurchaseReturn) GetVatSum() float64 {
-	return Deref(purchaseReturn.VatSum)
+	return Deref(purchaseReturn.VatSum).Float()
 }
 
 // GetPositions возвращает Метаданные позиций Возврата поставщику.
@@ -268,7 +269,7 @@ func (purchaseReturn PurchaseReturn) GetFactureOut() FactureOut {
 
 // GetPayedSum возвращает Сумму входящих платежей по возврату поставщику.
 func (purchaseReturn PurchaseReturn) GetPayedSum() float64 {
-	return Deref(purchaseReturn.PayedSum)
+	return Deref(purchaseReturn.PayedSum).Float()
 }
 
 // GetAttributes возвращает Список метаданных доп. полей.
@@ -482,7 +483,7 @@ func (purchaseReturn *PurchaseReturn) SetFactureOut(factureOut *FactureOut) *Pur
 
 // SetPayedSum устанавливает Сумму входящих платежей по возврату поставщику.
 func (purchaseReturn *PurchaseReturn) SetPayedSum(payedSum float64) *PurchaseReturn {
-	purchaseReturn.PayedSum = &payedSum
+	purchaseReturn.PayedSum = NewMoney(MoneyFromFloat(payedSum))
 	return purchaseReturn
 }
 
@@ -532,7 +533,7 @@ type PurchaseReturnPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Slot       *Slot               `json:"slot,omitempty"`       // Ячейка на складе
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
@@ -570,7 +571,7 @@ func (purchaseReturnPosition PurchaseReturnPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (purchaseReturnPosition PurchaseReturnPosition) GetPrice() float64 {
-	return Deref(purchaseReturnPosition.Price)
+	return Deref(purchaseReturnPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
@@ -641,7 +642,7 @@ func (purchaseReturnPosition *PurchaseReturnPosition) SetPack(pack *Pack) *Purch
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (purchaseReturnPosition *PurchaseReturnPosition) SetPrice(price float64) *PurchaseReturnPosition {
-	purchaseReturnPosition.Price = &price
+	purchaseReturnPosition.Price = NewMoney(MoneyFromFloat(price))
 	return purchaseReturnPosition
 }
 
@@ -707,6 +708,21 @@ type PurchaseReturnService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PurchaseReturn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех возвратов поставщику.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PurchaseReturn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех возвратов поставщику.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[PurchaseReturn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех возвратов поставщику асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*PurchaseReturn, error]
+
 	// Create выполняет запрос на создание возврата поставщику.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -719,13 +735,13 @@ type PurchaseReturnService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение возвратов поставщику.
 	// Изменяемые возвраты поставщику должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список возвратов поставщику и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых возвратов поставщику.
-	CreateUpdateMany(ctx context.Context, purchaseReturnList Slice[PurchaseReturn], params ...func(*Params)) (*Slice[PurchaseReturn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых возвратов поставщику и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, purchaseReturnList Slice[PurchaseReturn], params ...func(*Params)) (*BulkResult[PurchaseReturn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление возвратов поставщику.
 	// Принимает контекст и множество возвратов поставщику.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*PurchaseReturn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*PurchaseReturn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление возврата поставщику по ID.
 	// Принимает контекст и ID возврата поставщику.
@@ -771,6 +787,11 @@ type PurchaseReturnService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PurchaseReturnPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PurchaseReturnPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -788,8 +809,8 @@ type PurchaseReturnService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*PurchaseReturnPosition) (*Slice[PurchaseReturnPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*PurchaseReturnPosition) (*BulkResult[PurchaseReturnPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -801,6 +822,12 @@ type PurchaseReturnService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*PurchaseReturnPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[PurchaseReturnPosition], key func(position *PurchaseReturnPosition) string) (*PositionSyncResult[PurchaseReturnPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -834,8 +861,8 @@ type PurchaseReturnService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -849,8 +876,8 @@ type PurchaseReturnService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -914,8 +941,8 @@ type PurchaseReturnService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
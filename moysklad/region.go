@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -121,6 +122,21 @@ type RegionService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Region], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех регионов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Region, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех регионов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Region]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех регионов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Region, error]
+
 	// GetByID выполняет запрос на получение отдельного региона по ID.
 	// Принимает контекст, ID региона и опционально объект параметров запроса Params.
 	// Возвращает найденный регион.
@@ -16,9 +16,9 @@ type ReportByOperationsStock struct {
 	Store        MetaWrapper `json:"store"`        // Метаданные склада документа
 	Moment       Timestamp   `json:"moment"`       // Дата документа
 	AvgStockDays float64     `json:"avgStockDays"` // Количество дней на складе
-	CostPerUnit  float64     `json:"costPerUnit"`  // Себестоимость за единицу
+	CostPerUnit  Money       `json:"costPerUnit"`  // Себестоимость за единицу
 	Stock        float64     `json:"stock"`        // Остатки
-	SumCost      float64     `json:"sumCost"`      // Сумма себестоимости
+	SumCost      Money       `json:"sumCost"`      // Сумма себестоимости
 }
 
 // ReportByOperationsReserve Отчет с резервами.
@@ -21,13 +21,13 @@ type ReportCounterparty struct {
 	Counterparty    ReportCounterpartyInfo `json:"counterparty"`    // Контрагент
 	Meta            Meta                   `json:"meta"`            // Метаданные Отчета по данному контрагенту
 	LastEventText   string                 `json:"lastEventText"`   // Текст последнего события
-	DemandsSum      float64                `json:"demandsSum"`      // Сумма продаж
-	DiscountsSum    float64                `json:"discountsSum"`    // Сумма скидок
-	AverageReceipt  float64                `json:"averageReceipt"`  // Средний чек
+	DemandsSum      Money                  `json:"demandsSum"`      // Сумма продаж
+	DiscountsSum    Money                  `json:"discountsSum"`    // Сумма скидок
+	AverageReceipt  Money                  `json:"averageReceipt"`  // Средний чек
 	BonusBalance    float64                `json:"bonusBalance"`    // Баллы
-	Profit          float64                `json:"profit"`          // Прибыль
-	ReturnsSum      float64                `json:"returnsSum"`      // Сумма возвратов
-	Balance         float64                `json:"balance"`         // Баланс
+	Profit          Money                  `json:"profit"`          // Прибыль
+	ReturnsSum      Money                  `json:"returnsSum"`      // Сумма возвратов
+	Balance         Money                  `json:"balance"`         // Баланс
 	DemandsCount    int                    `json:"demandsCount"`    // Количество продаж
 	ReturnsCount    int                    `json:"returnsCount"`    // Количество возвратов
 }
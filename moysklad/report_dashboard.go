@@ -22,11 +22,11 @@ type Dashboard struct {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-pokazateli-struktura-ob-ekta-pokazatelej-den-gi-za-period
 type DashboardMoney struct {
-	Income        float64 `json:"income"`        // Доходы за период
-	Outcome       float64 `json:"outcome"`       // Расходы за период
-	Balance       float64 `json:"balance"`       // Текущий баланс
-	TodayMovement float64 `json:"todayMovement"` // Дельта за сегодня
-	Movement      float64 `json:"movement"`      // Дельта за период
+	Income        Money `json:"income"`        // Доходы за период
+	Outcome       Money `json:"outcome"`       // Расходы за период
+	Balance       Money `json:"balance"`       // Текущий баланс
+	TodayMovement Money `json:"todayMovement"` // Дельта за сегодня
+	Movement      Money `json:"movement"`      // Дельта за период
 }
 
 // DashboardSalesOrders Продажи/Заказы за период.
@@ -36,8 +36,8 @@ type DashboardMoney struct {
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-pokazateli-struktura-ob-ekta-pokazatelej-prodazhi-za-period
 type DashboardSalesOrders struct {
 	Count          float64 `json:"count"`          // Количество продаж/заказов
-	Amount         float64 `json:"amount"`         // Прибыль
-	MovementAmount float64 `json:"movementAmount"` // Дельта по сравнению с прошлым аналогичным периодом
+	Amount         Money   `json:"amount"`         // Прибыль
+	MovementAmount Money   `json:"movementAmount"` // Дельта по сравнению с прошлым аналогичным периодом
 }
 
 // ReportDashboardService описывает методы сервиса для работы с отчётом показатели.
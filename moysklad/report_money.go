@@ -5,21 +5,21 @@ import (
 	"github.com/go-resty/resty/v2"
 )
 
-// Money Остатки денежных средств.
+// ReportMoney Остатки денежных средств.
 //
 // Код сущности: moneyreport
 //
 // [Документация МойСклад]
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-otchet-den-gi-ostatki-denezhnyh-sredstw
-type Money struct {
+type ReportMoney struct {
 	Account      MetaNameWrapper `json:"account"`      // Счет организации (не выводится для остатка кассы, так как касса одна на организацию)
 	Organization MetaNameWrapper `json:"organization"` // Организация
-	Balance      float64         `json:"balance"`      // Текущий остаток денежных средств
+	Balance      Money           `json:"balance"`      // Текущий остаток денежных средств
 }
 
 // MetaType возвращает код сущности.
-func (Money) MetaType() MetaType {
+func (ReportMoney) MetaType() MetaType {
 	return MetaTypeReportMoney
 }
 
@@ -34,8 +34,8 @@ type MoneyPlotSeries struct {
 	Context Context             `json:"context"` // Метаданные о выполнившем запрос сотруднике
 	Meta    Meta                `json:"meta"`    // Метаданные запроса
 	Series  []PlotSeriesElement `json:"series"`  // Массив показателей
-	Credit  float64             `json:"credit"`  // Доход
-	Debit   float64             `json:"debit"`   // Расход
+	Credit  Money               `json:"credit"`  // Доход
+	Debit   Money               `json:"debit"`   // Расход
 }
 
 // MetaType возвращает код сущности.
@@ -49,10 +49,10 @@ func (MoneyPlotSeries) MetaType() MetaType {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-otchet-den-gi-dwizhenie-denezhnyh-sredstw-pokazateli-series
 type PlotSeriesElement struct {
-	Date    string  `json:"date"`    // Дата
-	Credit  float64 `json:"credit"`  // Доход за период
-	Debit   float64 `json:"debit"`   // Расход за период
-	Balance float64 `json:"balance"` // Баланс (доход-расход)
+	Date    string `json:"date"`    // Дата
+	Credit  Money  `json:"credit"`  // Доход за период
+	Debit   Money  `json:"debit"`   // Расход за период
+	Balance Money  `json:"balance"` // Баланс (доход-расход)
 }
 
 // ReportMoneyService описывает методы сервиса для работы с отчётом Деньги.
@@ -65,7 +65,7 @@ type ReportMoneyService interface {
 	// GetMoney выполняет запрос на получение остатков денежных средств по кассам и счетам.
 	// Принимает контекст.
 	// Возвращает объект List.
-	GetMoney(ctx context.Context) (*List[Money], *resty.Response, error)
+	GetMoney(ctx context.Context) (*List[ReportMoney], *resty.Response, error)
 
 	// GetPlotSeriesAsync выполняет запрос на получение графика движения денежных средств (асинхронно).
 	// Принимает контекст и опционально объект параметров запроса Params.
@@ -75,7 +75,7 @@ type ReportMoneyService interface {
 	// GetMoneyReportAsync выполняет запрос на получение остатков денежных средств по кассам и счетам.
 	// Принимает контекст.
 	// Возвращает сервис для работы с контекстом асинхронного запроса.
-	GetMoneyReportAsync(ctx context.Context) (AsyncResultService[List[Money]], *resty.Response, error)
+	GetMoneyReportAsync(ctx context.Context) (AsyncResultService[List[ReportMoney]], *resty.Response, error)
 }
 
 const (
@@ -92,8 +92,8 @@ func (service *reportMoneyService) GetPlotSeries(ctx context.Context, params ...
 	return NewRequestBuilder[MoneyPlotSeries](service.client, EndpointReportMoneyPlotSeries).SetParams(params).Get(ctx)
 }
 
-func (service *reportMoneyService) GetMoney(ctx context.Context) (*List[Money], *resty.Response, error) {
-	return NewRequestBuilder[List[Money]](service.client, EndpointReportMoneyByAccount).Get(ctx)
+func (service *reportMoneyService) GetMoney(ctx context.Context) (*List[ReportMoney], *resty.Response, error) {
+	return NewRequestBuilder[List[ReportMoney]](service.client, EndpointReportMoneyByAccount).Get(ctx)
 }
 
 func (service *reportMoneyService) GetPlotSeriesAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[MoneyPlotSeries], *resty.Response, error) {
@@ -101,8 +101,8 @@ func (service *reportMoneyService) GetPlotSeriesAsync(ctx context.Context, param
 	return NewRequestBuilder[MoneyPlotSeries](service.client, EndpointReportMoneyPlotSeries).SetParams(params).Async(ctx)
 }
 
-func (service *reportMoneyService) GetMoneyReportAsync(ctx context.Context) (AsyncResultService[List[Money]], *resty.Response, error) {
-	return NewRequestBuilder[List[Money]](service.client, EndpointReportMoneyByAccount).SetParams([]func(*Params){WithAsync()}).Async(ctx)
+func (service *reportMoneyService) GetMoneyReportAsync(ctx context.Context) (AsyncResultService[List[ReportMoney]], *resty.Response, error) {
+	return NewRequestBuilder[List[ReportMoney]](service.client, EndpointReportMoneyByAccount).SetParams([]func(*Params){WithAsync()}).Async(ctx)
 }
 
 // NewReportMoneyService принимает [Client] и возвращает сервис для работы с отчётом Деньги.
@@ -17,12 +17,12 @@ type ProfitByAssortment struct {
 	ReturnCost     float64                `json:"returnCost"`     // Себестоимость возвратов в копейках
 	ReturnCostSum  float64                `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
 	ReturnPrice    float64                `json:"returnPrice"`    // Цена возвратов
-	ReturnSum      float64                `json:"returnSum"`      // Сумма возвратов
+	ReturnSum      Money                  `json:"returnSum"`      // Сумма возвратов
 	SellCost       float64                `json:"sellCost"`       // Себестоимость в копейках
 	Margin         float64                `json:"margin"`         // Рентабельность
 	SalesMargin    float64                `json:"salesMargin"`    // Рентабельность продаж
 	SellPrice      float64                `json:"sellPrice"`      // Цена продаж (средняя)
-	SellSum        float64                `json:"sellSum"`        // Сумма продаж
+	SellSum        Money                  `json:"sellSum"`        // Сумма продаж
 	ReturnQuantity float64                `json:"returnQuantity"` // Возвращенное количество
 	SellQuantity   float64                `json:"sellQuantity"`   // Проданное количество
 }
@@ -60,10 +60,10 @@ type ProfitByCounterparty struct {
 	Profit         float64         `json:"profit"`         // Прибыль
 	ReturnAvgCheck float64         `json:"returnAvgCheck"` // Средний чек возврата
 	ReturnCostSum  float64         `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
-	ReturnSum      float64         `json:"returnSum"`      // Сумма возвратов
+	ReturnSum      Money           `json:"returnSum"`      // Сумма возвратов
 	SalesAvgCheck  float64         `json:"salesAvgCheck"`  // Средний чек продаж
 	SellCostSum    float64         `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
-	SellSum        float64         `json:"sellSum"`        // Сумма продаж
+	SellSum        Money           `json:"sellSum"`        // Сумма продаж
 	ReturnCount    float64         `json:"returnCount"`    // Количество возвратов
 	SalesCount     float64         `json:"salesCount"`     // Количество продаж
 	SalesMargin    float64         `json:"salesMargin"`    // Рентабельность продаж
@@ -87,10 +87,10 @@ type ProfitByEmployee struct {
 	Profit         float64         `json:"profit"`         // Прибыль
 	ReturnAvgCheck float64         `json:"returnAvgCheck"` // Средний чек возврата
 	ReturnCostSum  float64         `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
-	ReturnSum      float64         `json:"returnSum"`      // Сумма возвратов
+	ReturnSum      Money           `json:"returnSum"`      // Сумма возвратов
 	SalesAvgCheck  float64         `json:"salesAvgCheck"`  // Средний чек продаж
 	SellCostSum    float64         `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
-	SellSum        float64         `json:"sellSum"`        // Сумма продаж
+	SellSum        Money           `json:"sellSum"`        // Сумма продаж
 	ReturnCount    float64         `json:"returnCount"`    // Количество возвратов
 	SalesCount     float64         `json:"salesCount"`     // Количество продаж
 	SalesMargin    float64         `json:"salesMargin"`    // Рентабельность продаж
@@ -146,10 +146,10 @@ type ProfitBySalesChannel struct {
 	Profit         float64                  `json:"profit"`         // Прибыль
 	ReturnAvgCheck float64                  `json:"returnAvgCheck"` // Средний чек возврата
 	ReturnCostSum  float64                  `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
-	ReturnSum      float64                  `json:"returnSum"`      // Сумма возвратов
+	ReturnSum      Money                    `json:"returnSum"`      // Сумма возвратов
 	SalesAvgCheck  float64                  `json:"salesAvgCheck"`  // Средний чек продаж
 	SellCostSum    float64                  `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
-	SellSum        float64                  `json:"sellSum"`        // Сумма продаж
+	SellSum        Money                    `json:"sellSum"`        // Сумма продаж
 	ReturnCount    float64                  `json:"returnCount"`    // Количество возвратов
 	SalesCount     float64                  `json:"salesCount"`     // Количество продаж
 	SalesMargin    float64                  `json:"salesMargin"`    // Рентабельность продаж
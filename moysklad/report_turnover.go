@@ -11,7 +11,7 @@ import (
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-otchet-oboroty-oboroty-po-towaram-struktura-ob-ekta-pokazateli-onperiodstart-onperiodend-income-outcome
 type TurnoverIncomeOutcome struct {
-	Sum      float64 `json:"sum"`      // Сумма себестоимости
+	Sum      Money   `json:"sum"`      // Сумма себестоимости
 	Quantity float64 `json:"quantity"` // Количество единиц товара
 }
 
@@ -52,8 +52,8 @@ type TurnoverByOperation struct {
 	Assortment TurnoverAssortment `json:"assortment"` // Краткое представление Товара или Модификации в отчете
 	Operation  TurnoverOperation  `json:"operation"`  // Документ, связанный с Товаром
 	Store      MetaNameWrapper    `json:"store"`      // Склад
-	Cost       float64            `json:"cost"`       // Себестоимость товара в копейках в документе
-	Sum        float64            `json:"sum"`        // Сумма себестоимостей в копейках
+	Cost       Money              `json:"cost"`       // Себестоимость товара в копейках в документе
+	Sum        Money              `json:"sum"`        // Сумма себестоимостей в копейках
 	Quantity   float64            `json:"quantity"`   // Количество товара в документе
 }
 
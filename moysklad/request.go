@@ -1,13 +1,15 @@
 package moysklad
 
 import (
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/go-resty/resty/v2"
-	"log"
+	"io"
+	"log/slog"
 	"net/http"
 	"reflect"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -16,11 +18,13 @@ import (
 type RequestBuilder[T any] struct {
 	client *Client
 	req    *resty.Request
+	err    error
 	uri    string
+	token  string // Токен последней попытки запроса, полученный из TokenSource
 }
 
 func NewRequestBuilder[T any](client *Client, uri string) *RequestBuilder[T] {
-	return &RequestBuilder[T]{client, client.R(), uri}
+	return &RequestBuilder[T]{client: client, req: client.R(), uri: uri}
 }
 
 // Context объект, содержащий метаданные о выполнившем запрос сотруднике.
@@ -51,27 +55,150 @@ func (requestBuilder *RequestBuilder[T]) SetHeader(header, value string) *Reques
 }
 
 func (requestBuilder *RequestBuilder[T]) SetParams(params []func(*Params)) *RequestBuilder[T] {
-	values := ApplyParams(params).Values()
+	p := applyParams(requestBuilder.client.Location(), params)
+	if err := p.Err(); err != nil {
+		requestBuilder.err = err
+	}
 
-	requestBuilder.req.SetQueryParamsFromValues(values)
+	requestBuilder.req.SetQueryParamsFromValues(p.Values())
 
 	return requestBuilder
 }
 
+// Send выполняет запрос с методом method и телом body.
+//
+// Значения [Timestamp] тела запроса и ответа передаются в часовом поясе учётной записи клиента (см. Config.Location).
 func (requestBuilder *RequestBuilder[T]) Send(ctx context.Context, method string, body any) (*T, *resty.Response, error) {
-	// Ограничения на количество запросов
-	requestBuilder.client.limits.Wait()
-	defer requestBuilder.client.limits.Done()
-	requestBuilder.waitLimits()
-
-	resp, err := requestBuilder.req.SetContext(ctx).SetBody(body).Execute(method, requestBuilder.uri)
-	requestBuilder.parseLimits(resp)
+	loc := requestBuilder.client.Location()
+	requestBuilder.req.SetBody(ConvertTimestamps(body, loc, Location()))
 
+	resp, err := requestBuilder.execute(ctx, method)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return parseResponse[T](resp)
+	result, resp, err := parseResponse[T](resp)
+	return ConvertTimestamps(result, Location(), loc), resp, err
+}
+
+// execute выполняет запрос с учётом ограничений на количество запросов
+// и повторяет его в соответствии с политикой [RetryPolicy] клиента.
+func (requestBuilder *RequestBuilder[T]) execute(ctx context.Context, method string) (*resty.Response, error) {
+	if requestBuilder.err != nil {
+		return nil, requestBuilder.err
+	}
+
+	refreshed := false
+
+	for attempt := 1; ; attempt++ {
+		resp, err := requestBuilder.executeOnce(ctx, method, attempt)
+
+		// при ответе 401 токен обновляется один раз, после чего запрос повторяется
+		if !refreshed && err == nil && resp.StatusCode() == http.StatusUnauthorized && requestBuilder.client.tokenSource != nil {
+			refreshed = true
+
+			if _, err := requestBuilder.client.tokenSource.Refresh(ctx, requestBuilder.token); err != nil {
+				requestBuilder.client.logger.WarnContext(ctx, "moysklad: token refresh failed", slog.Any("error", err))
+				return resp, &TokenSourceError{Err: err}
+			}
+
+			closeRawBody(resp)
+			continue
+		}
+
+		delay, retry := requestBuilder.client.retryPolicy.next(ctx, attempt, method, resp, err)
+		if !retry {
+			return resp, err
+		}
+
+		requestBuilder.client.metrics.ObserveRetry(EndpointTemplate(requestBuilder.uri), method)
+		requestBuilder.client.logger.WarnContext(ctx, "moysklad: retrying request",
+			slog.String("method", method),
+			slog.String("path", requestBuilder.uri),
+			slog.Int("attempt", attempt),
+			slog.Duration("delay", delay),
+			slog.Any("error", err),
+		)
+
+		closeRawBody(resp)
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return resp, err
+		}
+	}
+}
+
+// closeRawBody закрывает тело ответа, которое не прочитано, если запрос выполнялся без разбора ответа.
+func closeRawBody(resp *resty.Response) {
+	if resp != nil && resp.RawResponse != nil && resp.RawBody() != nil {
+		_ = resp.RawBody().Close()
+	}
+}
+
+// executeOnce выполняет одну попытку запроса с учётом ограничений на количество запросов
+// через цепочку [Middleware] клиента.
+func (requestBuilder *RequestBuilder[T]) executeOnce(ctx context.Context, method string, attempt int) (*resty.Response, error) {
+	request := &RequestInfo{
+		Method:  method,
+		Path:    requestBuilder.uri,
+		Query:   requestBuilder.req.QueryParam,
+		Header:  requestBuilder.req.Header,
+		Attempt: attempt,
+		Async:   requestBuilder.req.QueryParam.Get("async") == "true",
+	}
+
+	if tokenSource := requestBuilder.client.tokenSource; tokenSource != nil {
+		token, err := tokenSource.Token(ctx)
+		if err != nil {
+			return nil, &TokenSourceError{Err: err}
+		}
+		requestBuilder.token = token
+		requestBuilder.req.SetAuthToken(token)
+	}
+
+	metrics := requestBuilder.client.metrics
+
+	// Ограничения на количество запросов
+	wait := time.Now()
+	if err := requestBuilder.client.limits.Wait(ctx); err != nil {
+		return nil, err
+	}
+	defer requestBuilder.client.limits.Done()
+	metrics.ObserveLimiterWait(EndpointTemplate(request.Path), time.Since(wait))
+
+	response, err := chain(requestBuilder.roundTrip, requestBuilder.client.middlewares)(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response.Response, err
+}
+
+// roundTrip выполняет запрос.
+func (requestBuilder *RequestBuilder[T]) roundTrip(ctx context.Context, request *RequestInfo) (*ResponseInfo, error) {
+	metrics := requestBuilder.client.metrics
+	endpoint := EndpointTemplate(request.Path)
+
+	metrics.AddInFlight(1)
+	defer metrics.AddInFlight(-1)
+
+	start := time.Now()
+	resp, err := requestBuilder.req.SetContext(ctx).Execute(request.Method, request.Path)
+	response := newResponseInfo(resp, time.Since(start))
+	requestBuilder.client.limits.update(resp)
+	metrics.ObserveRequest(endpoint, request.Method, response.StatusCode, response.Duration)
+
+	requestBuilder.client.logger.DebugContext(ctx, "moysklad: request",
+		slog.String("method", request.Method),
+		slog.String("path", request.Path),
+		slog.String("query", request.Query.Encode()),
+		slog.Int("attempt", request.Attempt),
+		slog.Int("status", response.StatusCode),
+		slog.Duration("duration", response.Duration),
+		slog.Int("rateRemaining", response.RateRemaining),
+		slog.Any("error", err),
+	)
+
+	return response, err
 }
 
 func (requestBuilder *RequestBuilder[T]) Get(ctx context.Context) (*T, *resty.Response, error) {
@@ -88,29 +215,93 @@ func (requestBuilder *RequestBuilder[T]) Post(ctx context.Context, body any) (*T
 
 func (requestBuilder *RequestBuilder[T]) Delete(ctx context.Context) (bool, *resty.Response, error) {
 	_, resp, err := requestBuilder.Send(ctx, http.MethodDelete, nil)
-	return resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusNoContent, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusNoContent, resp, nil
 }
 
+// Async создаёт асинхронную задачу.
+//
+// Перед созданием задачи занимается место в очереди клиента: одновременно выполняется не более [MaxAsyncTasks] задач,
+// остальные вызовы ожидают освобождения места (см. [AsyncResultService]).
 func (requestBuilder *RequestBuilder[T]) Async(ctx context.Context) (AsyncResultService[T], *resty.Response, error) {
-	// Ограничения на количество запросов
-	requestBuilder.client.limits.Wait()
-	defer requestBuilder.client.limits.Done()
-	requestBuilder.waitLimits()
+	async, resp, err := requestBuilder.async(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	return async, resp, nil
+}
+
+// async занимает место в очереди асинхронных задач, создаёт задачу и возвращает сервис для работы с ней.
+//
+// При ошибке создания задачи место освобождается.
+func (requestBuilder *RequestBuilder[T]) async(ctx context.Context) (*asyncResultService[T], *resty.Response, error) {
+	release, err := requestBuilder.client.asyncTasks.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// устанавливаем флаг async=true на создание асинхронной операции
-	requestBuilder.req.SetContext(ctx).SetQueryParam("async", "true")
+	requestBuilder.req.SetQueryParam("async", "true")
 
-	resp, err := requestBuilder.req.Get(requestBuilder.uri)
-	requestBuilder.parseLimits(resp)
+	resp, err := requestBuilder.execute(ctx, http.MethodGet)
+	if err == nil && resp.StatusCode() >= http.StatusBadRequest {
+		_, _, err = parseResponse[any](resp)
+	}
 	if err != nil {
+		release()
 		return nil, resp, err
 	}
 
-	async := NewAsyncResultService[T](requestBuilder.client, resp)
-
+	async := newAsyncResultService[T](requestBuilder.client, resp)
+	async.release = sync.OnceFunc(release)
 	return async, resp, nil
 }
 
+// Stream выполняет GET-запрос и возвращает тело ответа без чтения в память.
+//
+// Тело ответа необходимо закрыть после чтения.
+func (requestBuilder *RequestBuilder[T]) Stream(ctx context.Context) (io.ReadCloser, *resty.Response, error) {
+	requestBuilder.req.SetDoNotParseResponse(true)
+
+	resp, err := requestBuilder.execute(ctx, http.MethodGet)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	body := resp.RawBody()
+
+	if resp.StatusCode() >= http.StatusBadRequest {
+		data, _ := io.ReadAll(body)
+		_ = body.Close()
+		_, _, err = parseResponse[any](resp.SetBody(data))
+		return nil, resp, err
+	}
+
+	if !strings.EqualFold(resp.Header().Get("Content-Encoding"), "gzip") {
+		return body, resp, nil
+	}
+
+	reader, err := gzip.NewReader(body)
+	if err != nil {
+		_ = body.Close()
+		return nil, resp, err
+	}
+	return &gzipReadCloser{reader, body}, resp, nil
+}
+
+// gzipReadCloser распаковывает тело ответа и закрывает его вместе с распаковщиком.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.Closer
+}
+
+// Close реализует интерфейс [io.Closer].
+func (gzipReadCloser *gzipReadCloser) Close() error {
+	return errors.Join(gzipReadCloser.Reader.Close(), gzipReadCloser.body.Close())
+}
+
 // FetchMeta позволяет выполнить точечный запрос по переданному объекту [Meta].
 //
 // Необходимо точно указать обобщённый тип T, который ожидаем получить в ответ, иначе есть риск получить ошибку.
@@ -118,10 +309,15 @@ func FetchMeta[T any](ctx context.Context, client *Client, meta Meta, params ...
 	return NewRequestBuilder[T](client, strings.ReplaceAll(meta.GetHref(), baseApiURL, "")).SetParams(params).Get(ctx)
 }
 
-// TODO: improve
+// parseResponse десериализует тело ответа в объект типа T.
+//
+// При статусе ответа 4xx/5xx возвращает ошибку [ApiErrors], содержащую HTTP статус и URL запроса.
 func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
 	// check empty response body
 	if r.Body() == nil {
+		if r.StatusCode() >= http.StatusBadRequest {
+			return nil, r, newApiErrors(r, nil)
+		}
 		return nil, r, nil
 	}
 
@@ -146,7 +342,7 @@ func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
 		case statusCode >= http.StatusBadRequest: // error
 			var rawSlice []any
 			if err := json.Unmarshal(bodyBytes, &rawSlice); err != nil {
-				return nil, r, err
+				return nil, r, newApiErrors(r, nil)
 			}
 
 			resultType := reflect.TypeOf(result)
@@ -160,8 +356,7 @@ func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
 			}
 
 			if resultType.Kind() != reflect.Struct {
-				log.Printf("[DEBUG] resultType is not a struct: %v", resultType.Kind())
-				return nil, r, nil
+				return nil, r, newApiErrors(r, nil)
 			}
 
 			data := reflect.New(reflect.TypeOf(result)).Interface()
@@ -174,8 +369,7 @@ func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
 			if dataType.Kind() == reflect.Slice {
 				dataType = dataType.Elem()
 			} else {
-				log.Printf("[DEBUG] dataType is %v", dataType.Kind())
-				return nil, r, nil
+				return nil, r, newApiErrors(r, nil)
 			}
 
 			dataValue := reflect.ValueOf(data)
@@ -230,178 +424,128 @@ func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
 			}
 
 		case statusCode >= http.StatusBadRequest: // error
-			if err := json.Unmarshal(bodyBytes, &apiErrors); err != nil {
-				return nil, r, err
-			}
+			// тело ответа может не содержать ошибок в формате JSON (например, при 502 от прокси)
+			_ = json.Unmarshal(bodyBytes, &apiErrors)
 		}
 	}
 
-	if len(apiErrors.ApiErrors) > 0 {
-		return &result, r, apiErrors
+	if len(apiErrors.ApiErrors) > 0 || r.StatusCode() >= http.StatusBadRequest {
+		return &result, r, newApiErrors(r, apiErrors.ApiErrors)
 	}
 
 	return &result, r, nil
 }
 
+// getAll выполняет запрос на получение всех объектов в виде списка.
+//
+// Первая страница запрашивается для получения общего количества объектов,
+// остальные страницы запрашиваются параллельно не более чем [MaxQueriesPerUser] воркерами.
+// При ошибке запроса одной из страниц запросы оставшихся страниц отменяются.
+// Объекты возвращаются в порядке смещения страниц.
+//
+// При использовании [WithStablePagination] объекты запрашиваются последовательно окнами по полям updated и id.
 func getAll[T any](ctx context.Context, client *Client, path string, params []func(*Params)) (*Slice[T], *resty.Response, error) {
-	var offset = 1
-	var perPage = MaxPositions
-	var data Slice[T]
-
-	_params := append(params, WithLimit(offset), WithOffset(0))
-	list, resp, err := NewRequestBuilder[List[T]](client, path).SetParams(_params).Get(ctx)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	_paramsCheck := ApplyParams(_params)
-
-	// Если есть expand, изменяем размер страницы
-	if len(_paramsCheck.Expand) > 0 {
-		perPage = 100
-	}
-
-	// Добавляем первые данные
-	data = append(data, list.Rows...)
-	size := list.Meta.Size
-
-	// Канал для сбора данных из горутин
-	dataChan := make(chan Slice[T], 10)
-	defer close(dataChan)
-
-	// Обрабатываем оставшиеся страницы параллельно
-	for i := offset; i < size; i += perPage {
-		_params := append(_params[:], WithLimit(perPage), WithOffset(i))
-
-		// Запускаем горутину для каждого запроса
-		go func(i int, _params []func(*Params)) {
-			list, _, err = NewRequestBuilder[List[T]](client, path).SetParams(_params).Get(ctx)
+	if ApplyParams(params).stable {
+		var data Slice[T]
+		for row, err := range stableListSeq[T](ctx, client, path, params) {
 			if err != nil {
-				log.Println("getAll error:", err)
-				dataChan <- nil
-				return
+				return nil, nil, err
 			}
-			dataChan <- list.Rows
-		}(i, _params)
-	}
-
-	// Собираем результаты из канала
-	for i := offset; i < size; i += perPage {
-		rows := <-dataChan
-		if rows != nil {
-			data = append(data, rows...)
+			data = append(data, row)
 		}
+		return &data, nil, nil
 	}
 
-	return &data, resp, nil
-}
-
-func posAll[T any](ctx context.Context, client *Client, path string, entities Slice[T], params []func(*Params)) (*Slice[T], *resty.Response, error) {
-	if entities.Len() > MaxPositions {
-		var data Slice[T]
-		var resp *resty.Response
-		var mu sync.Mutex
-		var wg sync.WaitGroup
+	perPage := pageSize(params)
 
-		for _, chunk := range entities.IntoChunks(MaxPositions) {
-			wg.Add(1)
-
-			go func(chunk Slice[T]) {
-				defer wg.Done()
-
-				list, resResp, err := NewRequestBuilder[Slice[T]](client, path).SetParams(params).Post(ctx, chunk)
-
-				mu.Lock()
-				resp = resResp
-				mu.Unlock()
-
-				if err != nil {
-					log.Println("postAll error:", err)
-					return
-				}
-
-				if list.Len() > 0 {
-					mu.Lock()
-					data.Push(list.S()...)
-					mu.Unlock()
-				}
-			}(chunk)
-		}
-
-		wg.Wait()
-
-		return &data, resp, nil
+	first, resp, err := NewRequestBuilder[List[T]](client, path).SetParams(withParams(params, WithLimit(perPage), WithOffset(0))).Get(ctx)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return NewRequestBuilder[Slice[T]](client, path).SetParams(params).Post(ctx, entities)
-}
-
-func deleteAll[T MetaOwner](ctx context.Context, client *Client, path string, entities Slice[T]) (*DeleteManyResponse, *resty.Response, error) {
-	if entities.Len() > MaxPositions {
-		var data DeleteManyResponse
-		var resp *resty.Response
-		var mu sync.Mutex
-		var wg sync.WaitGroup
+	size := first.Meta.Size
+	pages := make([]Slice[T], (max(size, 1)+perPage-1)/perPage)
+	pages[0] = first.Rows
 
-		for _, chunk := range entities.IntoChunks(MaxPositions) {
-			wg.Add(1)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-			go func(chunk Slice[T], resp *resty.Response) {
-				defer wg.Done()
+	var (
+		jobs = make(chan int)
+		errs = make([]error, len(pages))
+		wg   sync.WaitGroup
+	)
 
-				list, resResp, err := NewRequestBuilder[DeleteManyResponse](client, path).Post(ctx, AsMetaWrapperSlice(chunk))
+	for range min(MaxQueriesPerUser, len(pages)-1) {
+		wg.Add(1)
 
-				mu.Lock()
-				resp = resResp
-				mu.Unlock()
+		go func() {
+			defer wg.Done()
 
+			for page := range jobs {
+				_params := withParams(params, WithLimit(perPage), WithOffset(page*perPage))
+				list, _, err := NewRequestBuilder[List[T]](client, path).SetParams(_params).Get(ctx)
 				if err != nil {
-					log.Println("deleteAll error:", err)
-					return
+					errs[page] = err
+					cancel()
+					continue
 				}
+				pages[page] = list.Rows
+			}
+		}()
+	}
 
-				mu.Lock()
-				data = append(data, Deref(list)...)
-				mu.Unlock()
-			}(chunk, resp)
+	for page := 1; page < len(pages) && ctx.Err() == nil; page++ {
+		select {
+		case jobs <- page:
+		case <-ctx.Done():
 		}
-
-		wg.Wait()
-
-		return &data, resp, nil
 	}
+	close(jobs)
 
-	return NewRequestBuilder[DeleteManyResponse](client, path).Post(ctx, AsMetaWrapperSlice(entities))
-}
+	wg.Wait()
 
-func (requestBuilder *RequestBuilder[T]) parseLimits(response *resty.Response) {
-	rateLimit, err := strconv.Atoi(response.Header().Get(headerRateLimit))
-	if err != nil || rateLimit <= 0 {
-		rateLimit = 1
+	if err := joinPageErrors(errs); err != nil {
+		return nil, resp, err
 	}
 
-	rateRemaining, err := strconv.Atoi(response.Header().Get(headerRateRemaining))
-	if err != nil {
-		rateRemaining = 44
+	if err := ctx.Err(); err != nil {
+		return nil, resp, err
 	}
 
-	retryTimeInterval, err := strconv.Atoi(response.Header().Get(headerRetryTimeInterval))
-	if err != nil {
-		retryTimeInterval = 3000
+	var data = make(Slice[T], 0, size)
+	for _, rows := range pages {
+		data = append(data, rows...)
 	}
 
-	t1 := Clamp(rateRemaining/rateLimit, 1, 1000)
-	t2 := Clamp(retryTimeInterval/rateLimit, 1, 1000)
-	td := time.Duration(2 * t2 * (1/t1 - 1))
+	return &data, resp, nil
+}
 
-	requestBuilder.client.mu.Lock()
-	defer requestBuilder.client.mu.Unlock()
+// joinPageErrors объединяет ошибки запросов страниц, пропуская ошибки отмены,
+// которые вызваны ошибкой другой страницы.
+func joinPageErrors(errs []error) error {
+	var joined []error
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			joined = append(joined, err)
+		}
+	}
+	if len(joined) == 1 {
+		return joined[0]
+	}
+	return errors.Join(joined...)
+}
 
-	requestBuilder.client.nextReqTime = time.Now().Add(time.Millisecond * td)
+// posAll выполняет запрос на массовое создание и/или изменение объектов.
+func posAll[T any](ctx context.Context, client *Client, path string, entities Slice[T], params []func(*Params)) (*BulkResult[T], *resty.Response, error) {
+	return bulkAll[T, T](ctx, client, path, entities, params, func(chunk Slice[T]) any {
+		return chunk
+	})
 }
 
-func (requestBuilder *RequestBuilder[T]) waitLimits() {
-	for time.Now().Before(requestBuilder.client.nextReqTime) {
-		time.After(50 * time.Millisecond)
-	}
+// deleteAll выполняет запрос на массовое удаление объектов.
+func deleteAll[T MetaOwner](ctx context.Context, client *Client, path string, entities Slice[T]) (*BulkResult[DeleteManyRow], *resty.Response, error) {
+	return bulkAll[T, DeleteManyRow](ctx, client, path, entities, nil, func(chunk Slice[T]) any {
+		return AsMetaWrapperSlice(chunk)
+	})
 }
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,9 +20,9 @@ type RetailDemand struct {
 	Agent               *Agent                           `json:"agent,omitempty"`               // Метаданные контрагента
 	AgentAccount        *AgentAccount                    `json:"agentAccount,omitempty"`        // Метаданные счета контрагента
 	Applicable          *bool                            `json:"applicable,omitempty"`          // Отметка о проведении
-	CashSum             *float64                         `json:"cashSum,omitempty"`             // Оплачено наличными
+	CashSum             *Money                           `json:"cashSum,omitempty"`             // Оплачено наличными
 	CheckNumber         *string                          `json:"checkNumber,omitempty"`         // Номер чека
-	CheckSum            *float64                         `json:"checkSum,omitempty"`            // Сумма Чека
+	CheckSum            *Money                           `json:"checkSum,omitempty"`            // Сумма Чека
 	Code                *string                          `json:"code,omitempty"`                // Код Розничной продажи
 	Contract            *NullValue[Contract]             `json:"contract,omitempty"`            // Метаданные договора
 	Created             *Timestamp                       `json:"created,omitempty"`             // Дата создания
@@ -38,20 +39,20 @@ type RetailDemand struct {
 	Meta                *Meta                            `json:"meta,omitempty"`                // Метаданные Розничной продажи
 	Moment              *Timestamp                       `json:"moment,omitempty"`              // Дата документа
 	Name                *string                          `json:"name,omitempty"`                // Наименование Розничной продажи
-	NoCashSum           *float64                         `json:"noCashSum,omitempty"`           // Оплачено картой
+	NoCashSum           *Money                           `json:"noCashSum,omitempty"`           // Оплачено картой
 	OFDCode             *string                          `json:"ofdCode,omitempty"`             // Код оператора фискальных данных
 	Organization        *Organization                    `json:"organization,omitempty"`        // Метаданные юрлица
 	OrganizationAccount *AgentAccount                    `json:"organizationAccount,omitempty"` // Метаданные счета юрлица
 	Owner               *Employee                        `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
-	PayedSum            *float64                         `json:"payedSum,omitempty"`            // Сумма входящих платежей
+	PayedSum            *Money                           `json:"payedSum,omitempty"`            // Сумма входящих платежей
 	Positions           *MetaArray[RetailDemandPosition] `json:"positions,omitempty"`           // Метаданные позиций Розничной продажи
-	PrepaymentCashSum   *float64                         `json:"prepaymentCashSum,omitempty"`   // Предоплата наличными
-	PrepaymentNoCashSum *float64                         `json:"prepaymentNoCashSum,omitempty"` // Предоплата картой
-	PrepaymentQRSum     *float64                         `json:"prepaymentQrSum,omitempty"`     // Предоплата по QR-коду
+	PrepaymentCashSum   *Money                           `json:"prepaymentCashSum,omitempty"`   // Предоплата наличными
+	PrepaymentNoCashSum *Money                           `json:"prepaymentNoCashSum,omitempty"` // Предоплата картой
+	PrepaymentQRSum     *Money                           `json:"prepaymentQrSum,omitempty"`     // Предоплата по QR-коду
 	Printed             *bool                            `json:"printed,omitempty"`             // Напечатан ли документ
 	Project             *NullValue[Project]              `json:"project,omitempty"`             // Метаданные проекта
 	Published           *bool                            `json:"published,omitempty"`           // Опубликован ли документ
-	QRSum               *float64                         `json:"qrSum,omitempty"`               // Оплачено по QR-коду
+	QRSum               *Money                           `json:"qrSum,omitempty"`               // Оплачено по QR-коду
 	Rate                *NullValue[Rate]                 `json:"rate,omitempty"`                // Валюта
 	RetailShift         *RetailShift                     `json:"retailShift,omitempty"`         // Метаданные Розничной смены
 	RetailStore         *RetailStore                     `json:"retailStore,omitempty"`         // Метаданные Точки продаж
@@ -59,12 +60,12 @@ type RetailDemand struct {
 	Shared              *bool                            `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]                `json:"state,omitempty"`               // Метаданные статуса Розничной продажи
 	Store               *Store                           `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                         `json:"sum,omitempty"`                 // Сумма Розничной продажи в копейках
+	Sum                 *Money                           `json:"sum,omitempty"`                 // Сумма Розничной продажи в копейках
 	SyncID              *string                          `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp                       `json:"updated,omitempty"`             // Момент последнего обновления Розничной продажи
 	VatEnabled          *bool                            `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
 	VatIncluded         *bool                            `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	VatSum              *float64                         `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Money                           `json:"vatSum,omitempty"`              // Сумма НДС
 	TaxSystem           TaxSystem                        `json:"taxSystem,omitempty"`           // Код системы налогообложения
 	Attributes          Slice[Attribute]                 `json:"attributes,omitempty"`          // Список метаданных доп. полей
 }
@@ -106,7 +107,7 @@ func (retailDemand RetailDemand) GetApplicable() bool {
 
 // GetCashSum возвращает Оплачено наличными.
 func (retailDemand RetailDemand) GetCashSum() float64 {
-	return Deref(retailDemand.CashSum)
+	return Deref(retailDemand.CashSum).Float()
 }
 
 // GetCheckNumber возвращает Номер чека.
@@ -116,7 +117,7 @@ func (retailDemand RetailDemand) GetCheckNumber() string {
 
 // GetCheckSum возвращает Сумму Чека.
 func (retailDemand RetailDemand) GetCheckSum() float64 {
-	return Deref(retailDemand.CheckSum)
+	return Deref(retailDemand.CheckSum).Float()
 }
 
 // GetCode возвращает Код Розничной продажи.
@@ -201,7 +202,7 @@ func (retailDemand RetailDemand) GetName() string {
 
 // GetNoCashSum возвращает Оплачено картой.
 func (retailDemand RetailDemand) GetNoCashSum() float64 {
-	return Deref(retailDemand.NoCashSum)
+	return Deref(retailDemand.NoCashSum).Float()
 }
 
 // GetOFDCode возвращает Код оператора фискальных данных.
@@ -226,7 +227,7 @@ func (retailDemand RetailDemand) GetOwner() Employee {
 
 // GetPayedSum возвращает Сумму входящих платежей.
 func (retailDemand RetailDemand) GetPayedSum() float64 {
-	return Deref(retailDemand.PayedSum)
+	return Deref(retailDemand.PayedSum).Float()
 }
 
 // GetPositions возвращает Метаданные позиций Розничной продажи.
@@ -236,17 +237,17 @@ func (retailDemand RetailDemand) GetPositions() MetaArray[RetailDemandPosition]
 
 // GetPrepaymentCashSum возвращает Предоплату наличными.
 func (retailDemand RetailDemand) GetPrepaymentCashSum() float64 {
-	return Deref(retailDemand.PrepaymentCashSum)
+	return Deref(retailDemand.PrepaymentCashSum).Float()
 }
 
 // GetPrepaymentNoCashSum возвращает Предоплату картой.
 func (retailDemand RetailDemand) GetPrepaymentNoCashSum() float64 {
-	return Deref(retailDemand.PrepaymentNoCashSum)
+	return Deref(retailDemand.PrepaymentNoCashSum).Float()
 }
 
 // GetPrepaymentQRSum возвращает Предоплату по QR-коду.
 func (retailDemand RetailDemand) GetPrepaymentQRSum() float64 {
-	return Deref(retailDemand.PrepaymentQRSum)
+	return Deref(retailDemand.PrepaymentQRSum).Float()
 }
 
 // GetPrinted возвращает true, если документ напечатан.
@@ -266,7 +267,7 @@ func (retailDemand RetailDemand) GetPublished() bool {
 
 // GetQRSum возвращает оплачено по QR-коду.
 func (retailDemand RetailDemand) GetQRSum() float64 {
-	return Deref(retailDemand.QRSum)
+	return Deref(retailDemand.QRSum).Float()
 }
 
 // GetRate возвращает Валюту.
@@ -306,7 +307,7 @@ func (retailDemand RetailDemand) GetStore() Store {
 
 // GetSum возвращает Сумму Розничной продажи в копейках.
 func (retailDemand RetailDemand) GetSum() float64 {
-	return Deref(retailDemand.Sum)
+	return Deref(retailDemand.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -331,7 +332,7 @@ func (retailDemand RetailDemand) GetVatIncluded() bool {
 
 // GetVatSum возвращает Сумму НДС.
 func (retailDemand RetailDemand) GetVatSum() float64 {
-	return Deref(retailDemand.VatSum)
+	return Deref(retailDemand.VatSum).Float()
 }
 
 // GetTaxSystem возвращает Код системы налогообложения.
@@ -370,7 +371,7 @@ func (retailDemand *RetailDemand) SetApplicable(applicable bool) *RetailDemand {
 
 // SetCashSum устанавливает Оплачено наличными.
 func (retailDemand *RetailDemand) SetCashSum(cashSum float64) *RetailDemand {
-	retailDemand.CashSum = &cashSum
+	retailDemand.CashSum = NewMoney(MoneyFromFloat(cashSum))
 	return retailDemand
 }
 
@@ -382,7 +383,7 @@ func (retailDemand *RetailDemand) SetCheckNumber(checkNumber string) *RetailDema
 
 // SetCheckSum устанавливает Сумму чека.
 func (retailDemand *RetailDemand) SetCheckSum(checkSum float64) *RetailDemand {
-	retailDemand.CheckSum = &checkSum
+	retailDemand.CheckSum = NewMoney(MoneyFromFloat(checkSum))
 	return retailDemand
 }
 
@@ -462,7 +463,7 @@ func (retailDemand *RetailDemand) SetName(name string) *RetailDemand {
 
 // SetNoCashSum устанавливает Оплачено картой.
 func (retailDemand *RetailDemand) SetNoCashSum(noCashSum float64) *RetailDemand {
-	retailDemand.NoCashSum = &noCashSum
+	retailDemand.NoCashSum = NewMoney(MoneyFromFloat(noCashSum))
 	return retailDemand
 }
 
@@ -500,19 +501,19 @@ func (retailDemand *RetailDemand) SetPositions(positions ...*RetailDemandPositio
 
 // SetPrepaymentCashSum устанавливает Предоплату наличными.
 func (retailDemand *RetailDemand) SetPrepaymentCashSum(prepaymentCashSum float64) *RetailDemand {
-	retailDemand.PrepaymentCashSum = &prepaymentCashSum
+	retailDemand.PrepaymentCashSum = NewMoney(MoneyFromFloat(prepaymentCashSum))
 	return retailDemand
 }
 
 // SetPrepaymentNoCashSum устанавливает Предоплату картой.
 func (retailDemand *RetailDemand) SetPrepaymentNoCashSum(prepaymentNoCashSum float64) *RetailDemand {
-	retailDemand.PrepaymentNoCashSum = &prepaymentNoCashSum
+	retailDemand.PrepaymentNoCashSum = NewMoney(MoneyFromFloat(prepaymentNoCashSum))
 	return retailDemand
 }
 
 // SetPrepaymentQRSum устанавливает Предоплату по QR-коду.
 func (retailDemand *RetailDemand) SetPrepaymentQRSum(prepaymentQRSum float64) *RetailDemand {
-	retailDemand.PrepaymentQRSum = &prepaymentQRSum
+	retailDemand.PrepaymentQRSum = NewMoney(MoneyFromFloat(prepaymentQRSum))
 	return retailDemand
 }
 
@@ -526,7 +527,7 @@ func (retailDemand *RetailDemand) SetProject(project *Project) *RetailDemand {
 
 // SetQRSum устанавливает Оплачено по QR-коду.
 func (retailDemand *RetailDemand) SetQRSum(qrSum float64) *RetailDemand {
-	retailDemand.QRSum = &qrSum
+	retailDemand.QRSum = NewMoney(MoneyFromFloat(qrSum))
 	return retailDemand
 }
 
@@ -649,11 +650,11 @@ func (retailDemand *RetailDemand) Delete(ctx context.Context, client *Client) (b
 type RetailDemandPosition struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
-	Cost       *float64            `json:"cost,omitempty"`       // Себестоимость (только для услуг)
+	Cost       *Money              `json:"cost,omitempty"`       // Себестоимость (только для услуг)
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
@@ -673,7 +674,7 @@ func (retailDemandPosition RetailDemandPosition) GetAssortment() AssortmentPosit
 
 // GetCost возвращает Себестоимость (только для услуг).
 func (retailDemandPosition RetailDemandPosition) GetCost() float64 {
-	return Deref(retailDemandPosition.Cost)
+	return Deref(retailDemandPosition.Cost).Float()
 }
 
 // GetDiscount возвращает Процент скидки или наценки.
@@ -695,7 +696,7 @@ func (retailDemandPosition RetailDemandPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (retailDemandPosition RetailDemandPosition) GetPrice() float64 {
-	return Deref(retailDemandPosition.Price)
+	return Deref(retailDemandPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в позиции.
@@ -741,7 +742,7 @@ func (retailDemandPosition *RetailDemandPosition) SetAssortment(assortment Assor
 
 // SetCost устанавливает Себестоимость (только для услуг).
 func (retailDemandPosition *RetailDemandPosition) SetCost(cost float64) *RetailDemandPosition {
-	retailDemandPosition.Cost = &cost
+	retailDemandPosition.Cost = NewMoney(MoneyFromFloat(cost))
 	return retailDemandPosition
 }
 
@@ -761,7 +762,7 @@ func (retailDemandPosition *RetailDemandPosition) SetPack(pack *Pack) *RetailDem
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (retailDemandPosition *RetailDemandPosition) SetPrice(price float64) *RetailDemandPosition {
-	retailDemandPosition.Price = &price
+	retailDemandPosition.Price = NewMoney(MoneyFromFloat(price))
 	return retailDemandPosition
 }
 
@@ -838,6 +839,21 @@ type RetailDemandService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailDemand], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех розничных продаж.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDemand, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех розничных продаж.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[RetailDemand]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех розничных продаж асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDemand, error]
+
 	// Create выполняет запрос на создание розничной продажи.
 	// Обязательные поля для заполнения:
 	//	- retailShift (Ссылка на Розничную смену, в рамках которой происходит продажа)
@@ -848,13 +864,13 @@ type RetailDemandService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение розничных продаж.
 	// Изменяемые розничные продажи должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список розничных продаж и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых розничных продаж.
-	CreateUpdateMany(ctx context.Context, retailDemandList Slice[RetailDemand], params ...func(*Params)) (*Slice[RetailDemand], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых розничных продаж и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, retailDemandList Slice[RetailDemand], params ...func(*Params)) (*BulkResult[RetailDemand], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление розничных продаж.
 	// Принимает контекст и множество розничных продаж.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*RetailDemand) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*RetailDemand) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление розничной продажи по ID.
 	// Принимает контекст и ID розничной продажи.
@@ -901,6 +917,11 @@ type RetailDemandService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[RetailDemandPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*RetailDemandPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -918,8 +939,8 @@ type RetailDemandService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*RetailDemandPosition) (*Slice[RetailDemandPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*RetailDemandPosition) (*BulkResult[RetailDemandPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -931,6 +952,12 @@ type RetailDemandService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*RetailDemandPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[RetailDemandPosition], key func(position *RetailDemandPosition) string) (*PositionSyncResult[RetailDemandPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -964,8 +991,8 @@ type RetailDemandService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -979,8 +1006,8 @@ type RetailDemandService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -1044,8 +1071,8 @@ type RetailDemandService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
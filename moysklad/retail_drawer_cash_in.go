@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -36,7 +37,7 @@ type RetailDrawerCashIn struct {
 	Rate         *NullValue[Rate]  `json:"rate,omitempty"`         // Валюта
 	Shared       *bool             `json:"shared,omitempty"`       // Общий доступ
 	State        *NullValue[State] `json:"state,omitempty"`        // Метаданные статуса Внесения денег
-	Sum          *float64          `json:"sum,omitempty"`          // Сумма Внесения денег в копейках
+	Sum          *Money            `json:"sum,omitempty"`          // Сумма Внесения денег в копейках
 	SyncID       *string           `json:"syncId,omitempty"`       // ID синхронизации
 	Updated      *Timestamp        `json:"updated,omitempty"`      // Момент последнего обновления Внесения денег
 	Attributes   Slice[Attribute]  `json:"attributes,omitempty"`   // Список метаданных доп. полей
@@ -164,7 +165,7 @@ func (retailDrawerCashIn RetailDrawerCashIn) GetState() State {
 
 // GetSum возвращает Сумму Перемещения в копейках.
 func (retailDrawerCashIn RetailDrawerCashIn) GetSum() float64 {
-	return Deref(retailDrawerCashIn.Sum)
+	return Deref(retailDrawerCashIn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -339,6 +340,21 @@ type RetailDrawerCashInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailDrawerCashIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех внесений денег.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDrawerCashIn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех внесений денег.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[RetailDrawerCashIn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех внесений денег асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDrawerCashIn, error]
+
 	// Create выполняет запрос на создание внесения денег.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -351,13 +367,13 @@ type RetailDrawerCashInService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение внесений денег.
 	// Изменяемые внесения денег должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список внесений денег и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых внесений денег.
-	CreateUpdateMany(ctx context.Context, retailDrawerCashInList Slice[RetailDrawerCashIn], params ...func(*Params)) (*Slice[RetailDrawerCashIn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых внесений денег и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, retailDrawerCashInList Slice[RetailDrawerCashIn], params ...func(*Params)) (*BulkResult[RetailDrawerCashIn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление внесений денег.
 	// Принимает контекст и множество внесений денег.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*RetailDrawerCashIn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*RetailDrawerCashIn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление внесения денег по ID.
 	// Принимает контекст и ID внесения денег.
@@ -414,8 +430,8 @@ type RetailDrawerCashInService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -429,8 +445,8 @@ type RetailDrawerCashInService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -494,8 +510,8 @@ type RetailDrawerCashInService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
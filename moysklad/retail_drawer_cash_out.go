@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -37,7 +38,7 @@ type RetailDrawerCashOut struct {
 	Rate         *NullValue[Rate]  `json:"rate,omitempty"`         // Валюта
 	Shared       *bool             `json:"shared,omitempty"`       // Общий доступ
 	State        *NullValue[State] `json:"state,omitempty"`        // Метаданные статуса Выплаты денег
-	Sum          *float64          `json:"sum,omitempty"`          // Сумма Выплаты денег установленной валюте
+	Sum          *Money            `json:"sum,omitempty"`          // Сумма Выплаты денег установленной валюте
 	SyncID       *string           `json:"syncId,omitempty"`       // ID синхронизации
 	Updated      *Timestamp        `json:"updated,omitempty"`      // Момент последнего обновления Выплаты денег
 	Attributes   Slice[Attribute]  `json:"attributes,omitempty"`   // Список метаданных доп. полей
@@ -170,7 +171,7 @@ func (retailDrawerCashOut RetailDrawerCashOut) GetState() State {
 
 // GetSum возвращает Сумму Выплаты денег в установленной валюте.
 func (retailDrawerCashOut RetailDrawerCashOut) GetSum() float64 {
-	return Deref(retailDrawerCashOut.Sum)
+	return Deref(retailDrawerCashOut.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -351,6 +352,21 @@ type RetailDrawerCashOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailDrawerCashOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех выплат денег.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDrawerCashOut, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех выплат денег.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[RetailDrawerCashOut]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех выплат денег асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDrawerCashOut, error]
+
 	// Create выполняет запрос на создание выплаты денег.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -363,13 +379,13 @@ type RetailDrawerCashOutService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение выплат денег.
 	// Изменяемые выплаты денег должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список выплат денег и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых выплат денег.
-	CreateUpdateMany(ctx context.Context, retailDrawerCashOutList Slice[RetailDrawerCashOut], params ...func(*Params)) (*Slice[RetailDrawerCashOut], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых выплат денег и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, retailDrawerCashOutList Slice[RetailDrawerCashOut], params ...func(*Params)) (*BulkResult[RetailDrawerCashOut], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление выплат денег.
 	// Принимает контекст и множество выплат денег.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*RetailDrawerCashOut) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*RetailDrawerCashOut) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление выплаты денег по ID.
 	// Принимает контекст и ID выплаты денег.
@@ -426,8 +442,8 @@ type RetailDrawerCashOutService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -441,8 +457,8 @@ type RetailDrawerCashOutService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -506,8 +522,8 @@ type RetailDrawerCashOutService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -20,7 +21,7 @@ type RetailSalesReturn struct {
 	AgentAccount        *AgentAccount                         `json:"agentAccount,omitempty"`        // Метаданные счета контрагента
 	Applicable          *bool                                 `json:"applicable,omitempty"`          // Отметка о проведении
 	VatIncluded         *bool                                 `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	CashSum             *float64                              `json:"cashSum,omitempty"`             // Оплачено наличными
+	CashSum             *Money                                `json:"cashSum,omitempty"`             // Оплачено наличными
 	Code                *string                               `json:"code,omitempty"`                // Код Розничного возврата
 	Contract            *NullValue[Contract]                  `json:"contract,omitempty"`            // Метаданные договора
 	Created             *Timestamp                            `json:"created,omitempty"`             // Дата создания
@@ -33,7 +34,7 @@ type RetailSalesReturn struct {
 	Meta                *Meta                                 `json:"meta,omitempty"`                // Метаданные Розничного возврата
 	Moment              *Timestamp                            `json:"moment,omitempty"`              // Дата документа
 	OrganizationAccount *AgentAccount                         `json:"organizationAccount,omitempty"` // Метаданные счета юрлица
-	NoCashSum           *float64                              `json:"noCashSum,omitempty"`           // Оплачено картой
+	NoCashSum           *Money                                `json:"noCashSum,omitempty"`           // Оплачено картой
 	SyncID              *string                               `json:"syncId,omitempty"`              // ID синхронизации
 	AccountID           *string                               `json:"accountId,omitempty"`           // ID учётной записи
 	Owner               *Employee                             `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
@@ -41,16 +42,16 @@ type RetailSalesReturn struct {
 	Printed             *bool                                 `json:"printed,omitempty"`             // Напечатан ли документ
 	Project             *NullValue[Project]                   `json:"project,omitempty"`             // Метаданные проекта
 	Published           *bool                                 `json:"published,omitempty"`           // Опубликован ли документ
-	QRSum               *float64                              `json:"qrSum,omitempty"`               // Оплачено по QR-коду
+	QRSum               *Money                                `json:"qrSum,omitempty"`               // Оплачено по QR-коду
 	Rate                *NullValue[Rate]                      `json:"rate,omitempty"`                // Валюта
 	RetailShift         *RetailShift                          `json:"retailShift,omitempty"`         // Метаданные Розничной смены
 	RetailStore         *RetailStore                          `json:"retailStore,omitempty"`         // Метаданные Точки продаж
 	Shared              *bool                                 `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]                     `json:"state,omitempty"`               // Метаданные статуса Розничного возврата
 	Store               *Store                                `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                              `json:"sum,omitempty"`                 // Сумма Розничного возврата в копейках
+	Sum                 *Money                                `json:"sum,omitempty"`                 // Сумма Розничного возврата в копейках
 	Agent               *Agent                                `json:"agent,omitempty"`               // Метаданные контрагента
-	VatSum              *float64                              `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Money                                `json:"vatSum,omitempty"`              // Сумма НДС
 	Updated             *Timestamp                            `json:"updated,omitempty"`             // Момент последнего обновления Розничного возврата
 	VatEnabled          *bool                                 `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
 	TaxSystem           TaxSystem                             `json:"taxSystem,omitempty"`           // Код системы налогообложения
@@ -99,7 +100,7 @@ func (retailSalesReturn RetailSalesReturn) GetVatIncluded() bool {
 
 // GetCashSum возвращает Оплачено наличными.
 func (retailSalesReturn RetailSalesReturn) GetCashSum() float64 {
-	return Deref(retailSalesReturn.CashSum)
+	return Deref(retailSalesReturn.CashSum).Float()
 }
 
 // GetCode возвращает Код Розничного возврата.
@@ -164,7 +165,7 @@ func (retailSalesReturn RetailSalesReturn) GetOrganizationAccount() AgentAccount
 
 // GetNoCashSum возвращает Оплачено картой.
 func (retailSalesReturn RetailSalesReturn) GetNoCashSum() float64 {
-	return Deref(retailSalesReturn.NoCashSum)
+	return Deref(retailSalesReturn.NoCashSum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -204,7 +205,7 @@ func (retailSalesReturn RetailSalesReturn) GetPublished() bool {
 
 // GetQRSum возвращает оплачено по QR-коду.
 func (retailSalesReturn RetailSalesReturn) GetQRSum() float64 {
-	return Deref(retailSalesReturn.QRSum)
+	return Deref(retailSalesReturn.QRSum).Float()
 }
 
 // GetRate возвращает Валюту.
@@ -239,7 +240,7 @@ func (retailSalesReturn RetailSalesReturn) GetStore() Store {
 
 // GetSum возвращает Сумму Розничного возврата в копейках.
 func (retailSalesReturn RetailSalesReturn) GetSum() float64 {
-	return Deref(retailSalesReturn.Sum)
+	return Deref(retailSalesReturn.Sum).Float()
 }
 
 // GetAgent возвращает Метаданные Контрагента.
@@ -249,7 +250,7 @@ func (retailSalesReturn RetailSalesReturn) GetAgent() Agent {
 
 // GetVatSum возвращает Сумму НДС.
 func (retailSalesReturn RetailSalesReturn) GetVatSum() float64 {
-	return Deref(retailSalesReturn.VatSum)
+	return Deref(retailSalesReturn.VatSum).Float()
 }
 
 // GetUpdated возвращает Момент последнего обновления Розничного возврата.
@@ -308,7 +309,7 @@ func (retailSalesReturn *RetailSalesReturn) SetVatIncluded(vatIncluded bool) *Re
 
 // SetCashSum устанавливает Оплачено наличными.
 func (retailSalesReturn *RetailSalesReturn) SetCashSum(cashSum float64) *RetailSalesReturn {
-	retailSalesReturn.CashSum = &cashSum
+	retailSalesReturn.CashSum = NewMoney(MoneyFromFloat(cashSum))
 	return retailSalesReturn
 }
 
@@ -376,7 +377,7 @@ func (retailSalesReturn *RetailSalesReturn) SetOrganizationAccount(organizationA
 
 // SetNoCashSum устанавливает Оплачено картой.
 func (retailSalesReturn *RetailSalesReturn) SetNoCashSum(noCashSum float64) *RetailSalesReturn {
-	retailSalesReturn.NoCashSum = &noCashSum
+	retailSalesReturn.NoCashSum = NewMoney(MoneyFromFloat(noCashSum))
 	return retailSalesReturn
 }
 
@@ -412,7 +413,7 @@ func (retailSalesReturn *RetailSalesReturn) SetProject(project *Project) *Retail
 
 // SetQRSum устанавливает Оплачено по QR-коду.
 func (retailSalesReturn *RetailSalesReturn) SetQRSum(qrSum float64) *RetailSalesReturn {
-	retailSalesReturn.QRSum = &qrSum
+	retailSalesReturn.QRSum = NewMoney(MoneyFromFloat(qrSum))
 	return retailSalesReturn
 }
 
@@ -527,11 +528,11 @@ func (retailSalesReturn *RetailSalesReturn) Delete(ctx context.Context, client *
 type RetailSalesReturnPosition struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
-	Cost       *float64            `json:"cost,omitempty"`       // Себестоимость (выводится, если документ был создан без основания)
+	Cost       *Money              `json:"cost,omitempty"`       // Себестоимость (выводится, если документ был создан без основания)
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
@@ -551,7 +552,7 @@ func (retailSalesReturnPosition RetailSalesReturnPosition) GetAssortment() Assor
 
 // GetCost возвращает Себестоимость (выводится, если документ был создан без основания).
 func (retailSalesReturnPosition RetailSalesReturnPosition) GetCost() float64 {
-	return Deref(retailSalesReturnPosition.Cost)
+	return Deref(retailSalesReturnPosition.Cost).Float()
 }
 
 // GetDiscount возвращает Процент скидки или наценки.
@@ -573,7 +574,7 @@ func (retailSalesReturnPosition RetailSalesReturnPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (retailSalesReturnPosition RetailSalesReturnPosition) GetPrice() float64 {
-	return Deref(retailSalesReturnPosition.Price)
+	return Deref(retailSalesReturnPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в позиции.
@@ -625,7 +626,7 @@ func (retailSalesReturnPosition *RetailSalesReturnPosition) SetAssortment(assort
 
 // SetCost устанавливает Себестоимость  (выводится, если документ был создан без основания).
 func (retailSalesReturnPosition *RetailSalesReturnPosition) SetCost(cost float64) *RetailSalesReturnPosition {
-	retailSalesReturnPosition.Cost = &cost
+	retailSalesReturnPosition.Cost = NewMoney(MoneyFromFloat(cost))
 	return retailSalesReturnPosition
 }
 
@@ -647,7 +648,7 @@ func (retailSalesReturnPosition *RetailSalesReturnPosition) SetPack(pack *Pack)
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (retailSalesReturnPosition *RetailSalesReturnPosition) SetPrice(price float64) *RetailSalesReturnPosition {
-	retailSalesReturnPosition.Price = &price
+	retailSalesReturnPosition.Price = NewMoney(MoneyFromFloat(price))
 	return retailSalesReturnPosition
 }
 
@@ -711,6 +712,21 @@ type RetailSalesReturnService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailSalesReturn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех розничных возвратов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailSalesReturn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех розничных возвратов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[RetailSalesReturn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех розничных возвратов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailSalesReturn, error]
+
 	// Create выполняет запрос на создание внесения денег.
 	// Обязательные поля для заполнения:
 	//	- name -(омер возврата)
@@ -729,13 +745,13 @@ type RetailSalesReturnService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение розничных возвратов.
 	// Изменяемые розничные возвраты должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список розничных возвратов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых розничных возвратов.
-	CreateUpdateMany(ctx context.Context, retailSalesReturnList Slice[RetailSalesReturn], params ...func(*Params)) (*Slice[RetailSalesReturn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых розничных возвратов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, retailSalesReturnList Slice[RetailSalesReturn], params ...func(*Params)) (*BulkResult[RetailSalesReturn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление розничных возвратов.
 	// Принимает контекст и множество розничных возвратов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*RetailSalesReturn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*RetailSalesReturn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление розничного возврата по ID.
 	// Принимает контекст и ID розничного возврата.
@@ -777,6 +793,11 @@ type RetailSalesReturnService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[RetailSalesReturnPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*RetailSalesReturnPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -794,8 +815,8 @@ type RetailSalesReturnService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*RetailSalesReturnPosition) (*Slice[RetailSalesReturnPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*RetailSalesReturnPosition) (*BulkResult[RetailSalesReturnPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -807,6 +828,12 @@ type RetailSalesReturnService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*RetailSalesReturnPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[RetailSalesReturnPosition], key func(position *RetailSalesReturnPosition) string) (*PositionSyncResult[RetailSalesReturnPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -840,8 +867,8 @@ type RetailSalesReturnService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -855,8 +882,8 @@ type RetailSalesReturnService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -920,8 +947,8 @@ type RetailSalesReturnService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,7 +20,7 @@ type RetailShift struct {
 	Shared              *bool                  `json:"shared,omitempty"`              // Общий доступ
 	AgentAccount        *AgentAccount          `json:"agentAccount,omitempty"`        // Метаданные счета контрагента
 	VatIncluded         *bool                  `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	BankCommission      *float64               `json:"bankComission,omitempty"`       // Сумма комиссии эквайера за проведение безналичных платежей по банковской карте. Не может превышать общую сумму безналичных платежей по карте. Если не указано, заполняется 0 автоматически.
+	BankCommission      *Money                 `json:"bankComission,omitempty"`       // Сумма комиссии эквайера за проведение безналичных платежей по банковской карте. Не может превышать общую сумму безналичных платежей по карте. Если не указано, заполняется 0 автоматически.
 	BankPercent         *float64               `json:"bankPercent,omitempty"`         // Комиссия банка-эквайера по операциям по карте (в процентах)
 	Name                *string                `json:"name,omitempty"`                // Наименование Розничной смены
 	CloseDate           *Timestamp             `json:"closeDate,omitempty"`           // Дата закрытия смены
@@ -39,14 +40,14 @@ type RetailShift struct {
 	OrganizationAccount *AgentAccount          `json:"organizationAccount,omitempty"` // Метаданные счета юрлица
 	Owner               *Employee              `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
 	Printed             *bool                  `json:"printed,omitempty"`             // Напечатан ли документ
-	ProceedsCash        *float64               `json:"proceedsCash,omitempty"`        // Выручка наличными
-	ProceedsNoCash      *float64               `json:"proceedsNoCash,omitempty"`      // Выручка безнал
+	ProceedsCash        *Money                 `json:"proceedsCash,omitempty"`        // Выручка наличными
+	ProceedsNoCash      *Money                 `json:"proceedsNoCash,omitempty"`      // Выручка безнал
 	Published           *bool                  `json:"published,omitempty"`           // Опубликован ли документ
 	QRAcquire           *Agent                 `json:"qrAcquire,omitempty"`           // Метаданные Банка-эквайера по операциям по QR-коду
-	QRBankCommission    *float64               `json:"qrBankComission,omitempty"`     // Сумма комиссии эквайера за проведение безналичных платежей по QR-коду. Не может превышать общую сумму безналичных платежей по QR-коду. Если не указано, заполняется 0 автоматически.
+	QRBankCommission    *Money                 `json:"qrBankComission,omitempty"`     // Сумма комиссии эквайера за проведение безналичных платежей по QR-коду. Не может превышать общую сумму безналичных платежей по QR-коду. Если не указано, заполняется 0 автоматически.
 	QRBankPercent       *float64               `json:"qrBankPercent,omitempty"`       // Комиссия банка-эквайера по операция по QR-коду (в процентах)
-	ReceivedCash        *float64               `json:"receivedCash,omitempty"`        // Получено наличными
-	ReceivedNoCash      *float64               `json:"receivedNoCash,omitempty"`      // Получено безнал
+	ReceivedCash        *Money                 `json:"receivedCash,omitempty"`        // Получено наличными
+	ReceivedNoCash      *Money                 `json:"receivedNoCash,omitempty"`      // Получено безнал
 	RetailStore         *RetailStore           `json:"retailStore,omitempty"`         // Метаданные точки продаж
 	Store               *Store                 `json:"store,omitempty"`               // Метаданные склада. Если не указано, заполняется с точки продаж автоматически
 	SyncID              *string                `json:"syncId,omitempty"`              // ID синхронизации
@@ -118,7 +119,7 @@ func (retailShift RetailShift) GetVatIncluded() bool {
 //
 // Если не указано, заполняется 0 автоматически.
 func (retailShift RetailShift) GetBankCommission() float64 {
-	return Deref(retailShift.BankCommission)
+	return Deref(retailShift.BankCommission).Float()
 }
 
 // GetBankPercent возвращает Комиссия банка-эквайера по операциям по карте (в процентах).
@@ -218,12 +219,12 @@ func (retailShift RetailShift) GetPrinted() bool {
 
 // GetProceedsCash возвращает Выручку наличными.
 func (retailShift RetailShift) GetProceedsCash() float64 {
-	return Deref(retailShift.ProceedsCash)
+	return Deref(retailShift.ProceedsCash).Float()
 }
 
 // GetProceedsNoCash возвращает Выручку безнала.
 func (retailShift RetailShift) GetProceedsNoCash() float64 {
-	return Deref(retailShift.ProceedsNoCash)
+	return Deref(retailShift.ProceedsNoCash).Float()
 }
 
 // GetPublished возвращает true, если документ опубликован.
@@ -242,7 +243,7 @@ func (retailShift RetailShift) GetQRAcquire() Agent {
 //
 // Если не указано, заполняется 0 автоматически.
 func (retailShift RetailShift) GetQRBankCommission() float64 {
-	return Deref(retailShift.QRBankCommission)
+	return Deref(retailShift.QRBankCommission).Float()
 }
 
 // GetQRBankPercent возвращает Комиссию банка-эквайера по операция по QR-коду (в процентах).
@@ -252,12 +253,12 @@ func (retailShift RetailShift) GetQRBankPercent() float64 {
 
 // GetReceivedCash возвращает Получено наличными.
 func (retailShift RetailShift) GetReceivedCash() float64 {
-	return Deref(retailShift.ReceivedCash)
+	return Deref(retailShift.ReceivedCash).Float()
 }
 
 // GetReceivedNoCash возвращает Получено безнала.
 func (retailShift RetailShift) GetReceivedNoCash() float64 {
-	return Deref(retailShift.ReceivedNoCash)
+	return Deref(retailShift.ReceivedNoCash).Float()
 }
 
 // GetRetailStore возвращает Метаданные Точки продаж.
@@ -326,7 +327,7 @@ func (retailShift *RetailShift) SetVatIncluded(vatIncluded bool) *RetailShift {
 //
 // Если не указано, заполняется 0 автоматически.
 func (retailShift *RetailShift) SetBankCommission(bankCommission float64) *RetailShift {
-	retailShift.BankCommission = &bankCommission
+	retailShift.BankCommission = NewMoney(MoneyFromFloat(bankCommission))
 	return retailShift
 }
 
@@ -418,7 +419,7 @@ func (retailShift *RetailShift) SetQRAcquire(qrAcquire AgentOrganizationConverte
 //
 // Если не указано, заполняется 0 автоматически.
 func (retailShift *RetailShift) SetQRBankCommission(qrBankCommission float64) *RetailShift {
-	retailShift.QRBankCommission = &qrBankCommission
+	retailShift.QRBankCommission = NewMoney(MoneyFromFloat(qrBankCommission))
 	return retailShift
 }
 
@@ -545,6 +546,21 @@ type RetailShiftService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailShift], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех розничных смен.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailShift, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех розничных смен.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[RetailShift]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех розничных смен асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailShift, error]
+
 	// Create выполняет запрос на создание розничной смены.
 	// Обязательные поля для заполнения:
 	//	- organization (Метаданные юрлица)
@@ -596,8 +612,8 @@ type RetailShiftService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -611,8 +627,8 @@ type RetailShiftService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetBySyncID выполняет запрос на получение отдельного документа по syncID.
 	// Принимает контекст и syncID документа.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -1330,6 +1331,21 @@ type RetailStoreService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailStore], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех точек продаж.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailStore, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех точек продаж.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[RetailStore]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех точек продаж асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailStore, error]
+
 	// Create выполняет запрос на создание точи продаж.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование точки продаж)
@@ -1343,13 +1359,13 @@ type RetailStoreService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение точек продаж.
 	// Изменяемые точки продаж должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список точек продаж и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых точек продаж.
-	CreateUpdateMany(ctx context.Context, retailStore Slice[RetailStore], params ...func(*Params)) (*Slice[RetailStore], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых точек продаж и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, retailStore Slice[RetailStore], params ...func(*Params)) (*BulkResult[RetailStore], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление точек продаж.
 	// Принимает контекст и множество точек продаж.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*RetailStore) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*RetailStore) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление точки продаж по ID.
 	// Принимает контекст и ID точки продаж.
@@ -0,0 +1,179 @@
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"github.com/go-resty/resty/v2"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultRetryMaxAttempts = 3                      // Количество попыток по умолчанию (включая первую)
+	DefaultRetryMinBackoff  = 500 * time.Millisecond // Начальная задержка между попытками по умолчанию
+	DefaultRetryMaxBackoff  = 10 * time.Second       // Максимальная задержка между попытками по умолчанию
+)
+
+// RetryPolicy политика повторного выполнения запросов.
+//
+// Запрос повторяется при получении ответа со статусом 429 (Too Many Requests), 5xx,
+// а также при сетевых ошибках (ошибки соединения, разрыв соединения, неожиданный конец ответа).
+// Ошибки источника токена ([TokenSourceError]) и прочие ошибки не приводят к повтору. Задержка между попытками растёт экспоненциально
+// (со случайным разбросом), но не может быть меньше значения заголовков
+// X-Lognex-Retry-After / X-Lognex-Retry-TimeInterval, если они присутствуют в ответе.
+//
+// Запросы с методом POST не являются идемпотентными, поэтому по умолчанию повторяются только
+// при ответе 429 и ошибке установки соединения, когда запрос гарантированно не был обработан.
+//
+// # Пример:
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token:       "MS_TOKEN_HERE",
+//		RetryPolicy: moysklad.DefaultRetryPolicy(),
+//	})
+type RetryPolicy struct {
+	// Максимальное количество попыток, включая первую.
+	//
+	// Значение меньше 2 отключает повторы.
+	MaxAttempts int
+
+	// Начальная задержка между попытками.
+	MinBackoff time.Duration
+
+	// Максимальная задержка между попытками.
+	MaxBackoff time.Duration
+
+	// Разрешает повторять запросы с методом POST при ответе 5xx и сетевых ошибках,
+	// после которых неизвестно, был ли запрос обработан.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy возвращает политику повторных запросов со значениями по умолчанию.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		MinBackoff:  DefaultRetryMinBackoff,
+		MaxBackoff:  DefaultRetryMaxBackoff,
+	}
+}
+
+// next возвращает задержку перед следующей попыткой и признак необходимости повтора.
+//
+// attempt – номер завершившейся попытки (начиная с 1).
+func (retryPolicy *RetryPolicy) next(ctx context.Context, attempt int, method string, resp *resty.Response, err error) (time.Duration, bool) {
+	if retryPolicy == nil || attempt >= retryPolicy.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	if !isRetryable(resp, err) {
+		return 0, false
+	}
+
+	if method == http.MethodPost && !retryPolicy.RetryNonIdempotent && !isNotProcessed(resp, err) {
+		return 0, false
+	}
+
+	return max(retryPolicy.backoff(attempt), retryAfter(resp)), true
+}
+
+// backoff возвращает экспоненциальную задержку со случайным разбросом в диапазоне [d/2, d].
+func (retryPolicy *RetryPolicy) backoff(attempt int) time.Duration {
+	minBackoff := retryPolicy.MinBackoff
+	if minBackoff <= 0 {
+		minBackoff = DefaultRetryMinBackoff
+	}
+
+	maxBackoff := retryPolicy.MaxBackoff
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	d := minBackoff
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, maxBackoff)
+
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// isRetryable возвращает true, если ответ или ошибка допускают повтор запроса.
+func isRetryable(resp *resty.Response, err error) bool {
+	if err != nil {
+		return isTransportError(err)
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	statusCode := resp.StatusCode()
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// isTransportError возвращает true, если err – сетевая ошибка выполнения запроса.
+func isTransportError(err error) bool {
+	var tokenSourceError *TokenSourceError
+	if errors.As(err, &tokenSourceError) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	// *url.Error реализует net.Error для любой ошибки запроса, поэтому проверяется исходная ошибка
+	var urlError *url.Error
+	if errors.As(err, &urlError) {
+		err = urlError.Err
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var netError net.Error
+	return errors.As(err, &netError)
+}
+
+// isNotProcessed возвращает true, если запрос гарантированно не был обработан сервисом:
+// получен ответ 429 или не удалось установить соединение.
+func isNotProcessed(resp *resty.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		return errors.Is(err, syscall.ECONNREFUSED) || (errors.As(err, &opErr) && opErr.Op == "dial")
+	}
+
+	return resp != nil && resp.StatusCode() == http.StatusTooManyRequests
+}
+
+// retryAfter возвращает время до сброса ограничения из заголовков ответа.
+func retryAfter(resp *resty.Response) time.Duration {
+	if resp == nil || resp.StatusCode() != http.StatusTooManyRequests {
+		return 0
+	}
+
+	for _, header := range []string{headerRetryAfter, headerRetryTimeInterval} {
+		if ms, err := strconv.Atoi(resp.Header().Get(header)); err == nil && ms > 0 {
+			return time.Duration(ms) * time.Millisecond
+		}
+	}
+
+	return 0
+}
+
+// sleepContext ожидает истечения задержки d либо отмены контекста.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
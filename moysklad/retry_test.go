@@ -0,0 +1,97 @@
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// statusResponse возвращает ответ со статусом statusCode.
+func statusResponse(statusCode int) *resty.Response {
+	return &resty.Response{RawResponse: &http.Response{StatusCode: statusCode, Header: make(http.Header)}}
+}
+
+func TestRetryPolicyNext(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+
+	tests := []struct {
+		name          string
+		method        string
+		nonIdempotent bool
+		resp          *resty.Response
+		err           error
+		want          bool
+	}{
+		{"get 429", http.MethodGet, false, statusResponse(http.StatusTooManyRequests), nil, true},
+		{"get 500", http.MethodGet, false, statusResponse(http.StatusInternalServerError), nil, true},
+		{"get 400", http.MethodGet, false, statusResponse(http.StatusBadRequest), nil, false},
+		{"get network", http.MethodGet, false, nil, io.ErrUnexpectedEOF, true},
+		{"get canceled", http.MethodGet, false, nil, context.Canceled, false},
+		{"post 429", http.MethodPost, false, statusResponse(http.StatusTooManyRequests), nil, true},
+		{"post refused", http.MethodPost, false, nil, refused, true},
+		{"post 500", http.MethodPost, false, statusResponse(http.StatusInternalServerError), nil, false},
+		{"post network", http.MethodPost, false, nil, io.ErrUnexpectedEOF, false},
+		{"post 500 non-idempotent", http.MethodPost, true, statusResponse(http.StatusInternalServerError), nil, true},
+		{"post network non-idempotent", http.MethodPost, true, nil, io.ErrUnexpectedEOF, true},
+		{"get url unexpected eof", http.MethodGet, false, nil, &url.Error{Op: "Get", Err: io.ErrUnexpectedEOF}, true},
+		{"get connection reset", http.MethodGet, false, nil, &url.Error{Op: "Get", Err: syscall.ECONNRESET}, true},
+		{"get url refused", http.MethodGet, false, nil, &url.Error{Op: "Get", Err: refused}, true},
+		{"get url other", http.MethodGet, false, nil, &url.Error{Op: "Get", Err: errors.New("unsupported protocol scheme")}, false},
+		{"get other", http.MethodGet, false, nil, errors.New("json: unsupported type"), false},
+		{"get token source", http.MethodGet, false, nil, &TokenSourceError{Err: refused}, false},
+		{"post token source", http.MethodPost, false, nil, &TokenSourceError{Err: refused}, false},
+	}
+
+	for _, test := range tests {
+		policy := DefaultRetryPolicy()
+		policy.RetryNonIdempotent = test.nonIdempotent
+
+		if _, got := policy.next(context.Background(), 1, test.method, test.resp, test.err); got != test.want {
+			t.Errorf("%s: got retry %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+// failingTokenSource источник токена, возвращающий ошибку err.
+type failingTokenSource struct {
+	err   error
+	calls atomic.Int32
+}
+
+func (source *failingTokenSource) Token(context.Context) (string, error) {
+	source.calls.Add(1)
+	return "", source.err
+}
+
+func (source *failingTokenSource) Refresh(ctx context.Context, _ string) (string, error) {
+	return source.Token(ctx)
+}
+
+func TestRetryTokenSourceError(t *testing.T) {
+	source := &failingTokenSource{err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}
+
+	client := New(Config{
+		BaseURL:     "http://127.0.0.1:0",
+		TokenSource: source,
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond},
+	})
+
+	_, _, err := client.Entity().Product().GetList(context.Background())
+
+	var tokenSourceError *TokenSourceError
+	if !errors.As(err, &tokenSourceError) || !errors.Is(err, syscall.ECONNREFUSED) {
+		t.Fatalf("got error %v, want wrapped token source error", err)
+	}
+	if calls := source.calls.Load(); calls != 1 {
+		t.Fatalf("got %d token requests, want 1", calls)
+	}
+}
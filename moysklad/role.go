@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Role Пользовательская роль.
@@ -329,6 +330,21 @@ type RoleService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Role], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех пользовательских ролей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Role, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех пользовательских ролей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Role]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех пользовательских ролей асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Role, error]
+
 	// Create выполняет запрос на создание пользовательской роли.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование пользовательской роли)
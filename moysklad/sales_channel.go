@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -274,6 +275,21 @@ type SalesChannelService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[SalesChannel], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех каналов продаж.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*SalesChannel, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех каналов продаж.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[SalesChannel]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех каналов продаж асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*SalesChannel, error]
+
 	// Create выполняет запрос на создание канала продаж.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование Канала продаж)
@@ -285,13 +301,13 @@ type SalesChannelService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение каналов продаж.
 	// Изменяемые каналы продаж должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список каналов продаж и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых каналов продаж.
-	CreateUpdateMany(ctx context.Context, salesChannelList Slice[SalesChannel], params ...func(*Params)) (*Slice[SalesChannel], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых каналов продаж и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, salesChannelList Slice[SalesChannel], params ...func(*Params)) (*BulkResult[SalesChannel], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление каналов продаж.
 	// Принимает контекст и множество каналов продаж.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*SalesChannel) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*SalesChannel) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление канала продаж по ID.
 	// Принимает контекст и ID канала продаж.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -16,7 +17,7 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-vozwrat-pokupatelq
 type SalesReturn struct {
 	Positions           *MetaArray[SalesReturnPosition] `json:"positions,omitempty"`           // Метаданные позиций Возврата Покупателя
-	VatSum              *float64                        `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Money                          `json:"vatSum,omitempty"`              // Сумма НДС
 	AgentAccount        *AgentAccount                   `json:"agentAccount,omitempty"`        // Метаданные счета контрагента
 	Applicable          *bool                           `json:"applicable,omitempty"`          // Отметка о проведении
 	FactureOut          *FactureOut                     `json:"factureOut,omitempty"`          // Ссылка на Счет-фактуру выданный, с которым связан этот возврат
@@ -44,7 +45,7 @@ type SalesReturn struct {
 	Shared              *bool                           `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]               `json:"state,omitempty"`               // Метаданные статуса Возврата Покупателя
 	Store               *Store                          `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                        `json:"sum,omitempty"`                 // Сумма Возврата Покупателя в копейках
+	Sum                 *Money                          `json:"sum,omitempty"`                 // Сумма Возврата Покупателя в копейках
 	SyncID              *string                         `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp                      `json:"updated,omitempty"`             // Момент последнего обновления Возврата Покупателя
 	VatEnabled          *bool                           `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
@@ -53,7 +54,7 @@ type SalesReturn struct {
 	Demand              *Demand                         `json:"demand,omitempty"`              // Ссылка на отгрузку, по которой произошел возврат
 	Losses              Slice[Loss]                     `json:"losses,omitempty"`              // Массив ссылок на связанные списания
 	Payments            Slice[Payment]                  `json:"payments,omitempty"`            // Массив ссылок на связанные платежи
-	PayedSum            *float64                        `json:"payedSum,omitempty"`            // Сумма исходящих платежей по возврату покупателя
+	PayedSum            *Money                          `json:"payedSum,omitempty"`            // Сумма исходящих платежей по возврату покупателя
 	Attributes          Slice[Attribute]                `json:"attributes,omitempty"`          // Список метаданных доп. полей
 }
 
@@ -81,7 +82,7 @@ func (salesReturn SalesReturn) GetPositions() MetaArray[SalesReturnPosition] {
 
 // GetVatSum возвращает Сумму НДС.
 func (salesReturn SalesReturn) GetVatSum() float64 {
-	return Deref(salesReturn.VatSum)
+	return Deref(salesReturn.VatSum).Float()
 }
 
 // GetAgentAccount возвращает Метаданные счета контрагента.
@@ -221,7 +222,7 @@ func (salesReturn SalesReturn) GetStore() Store {
 
 // GetSum возвращает Сумму Возврата Покупателя в копейках.
 func (salesReturn SalesReturn) GetSum() float64 {
-	return Deref(salesReturn.Sum)
+	return Deref(salesReturn.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -266,7 +267,7 @@ func (salesReturn SalesReturn) GetPayments() Slice[Payment] {
 
 // GetPayedSum возвращает Сумму исходящих платежей по возврату покупателя.
 func (salesReturn SalesReturn) GetPayedSum() float64 {
-	return Deref(salesReturn.PayedSum)
+	return Deref(salesReturn.PayedSum).Float()
 }
 
 // GetAttributes возвращает Список метаданных доп. полей.
@@ -528,8 +529,8 @@ func (salesReturn *SalesReturn) Delete(ctx context.Context, client *Client) (boo
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-vozwrat-pokupatelq-vozwraty-pokupatelej-pozicii-vozwrata-pokupatelq
 type SalesReturnPosition struct {
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Cost       *float64            `json:"cost,omitempty"`       // Себестоимость (выводится, если документ был создан без основания)
+	Price      *Money              `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Cost       *Money              `json:"cost,omitempty"`       // Себестоимость (выводится, если документ был создан без основания)
 	Country    *Country            `json:"country,omitempty"`    // Метаданные Страны
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	GTD        *GTD                `json:"gtd,omitempty"`        // ГТД
@@ -556,7 +557,7 @@ func (salesReturnPosition SalesReturnPosition) GetAssortment() AssortmentPositio
 
 // GetCost возвращает Себестоимость (выводится, если документ был создан без основания).
 func (salesReturnPosition SalesReturnPosition) GetCost() float64 {
-	return Deref(salesReturnPosition.Cost)
+	return Deref(salesReturnPosition.Cost).Float()
 }
 
 // GetCountry возвращает Метаданные Страны.
@@ -588,7 +589,7 @@ func (salesReturnPosition SalesReturnPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (salesReturnPosition SalesReturnPosition) GetPrice() float64 {
-	return Deref(salesReturnPosition.Price)
+	return Deref(salesReturnPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в позиции.
@@ -645,7 +646,7 @@ func (salesReturnPosition *SalesReturnPosition) SetAssortment(assortment Assortm
 
 // SetCost устанавливает Себестоимость (выводится, если документ был создан без основания).
 func (salesReturnPosition *SalesReturnPosition) SetCost(cost float64) *SalesReturnPosition {
-	salesReturnPosition.Cost = &cost
+	salesReturnPosition.Cost = NewMoney(MoneyFromFloat(cost))
 	return salesReturnPosition
 }
 
@@ -679,7 +680,7 @@ func (salesReturnPosition *SalesReturnPosition) SetPack(pack *Pack) *SalesReturn
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (salesReturnPosition *SalesReturnPosition) SetPrice(price float64) *SalesReturnPosition {
-	salesReturnPosition.Price = &price
+	salesReturnPosition.Price = NewMoney(MoneyFromFloat(price))
 	return salesReturnPosition
 }
 
@@ -751,6 +752,21 @@ type SalesReturnService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[SalesReturn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех возвратов покупателей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*SalesReturn, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех возвратов покупателей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[SalesReturn]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех возвратов покупателей асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*SalesReturn, error]
+
 	// Create выполняет запрос на создание возврата покупателя.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -764,13 +780,13 @@ type SalesReturnService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение возвратов покупателя.
 	// Изменяемые возвраты покупателя должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список возвратов покупателя и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых возвратов покупателя.
-	CreateUpdateMany(ctx context.Context, salesReturnList Slice[SalesReturn], params ...func(*Params)) (*Slice[SalesReturn], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых возвратов покупателя и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, salesReturnList Slice[SalesReturn], params ...func(*Params)) (*BulkResult[SalesReturn], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление возвратов покупателя.
 	// Принимает контекст и множество возвратов покупателя.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*SalesReturn) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*SalesReturn) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление возврата покупателя по ID.
 	// Принимает контекст и ID возврата покупателя.
@@ -816,6 +832,11 @@ type SalesReturnService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[SalesReturnPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*SalesReturnPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -833,8 +854,8 @@ type SalesReturnService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*SalesReturnPosition) (*Slice[SalesReturnPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*SalesReturnPosition) (*BulkResult[SalesReturnPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -846,6 +867,12 @@ type SalesReturnService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, entities ...*SalesReturnPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[SalesReturnPosition], key func(position *SalesReturnPosition) string) (*PositionSyncResult[SalesReturnPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -879,8 +906,8 @@ type SalesReturnService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -894,8 +921,8 @@ type SalesReturnService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -959,8 +986,8 @@ type SalesReturnService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -0,0 +1,282 @@
+package moysklad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"time"
+)
+
+// ListSeqPrefetch количество страниц, которые запрашиваются заранее при переборе итератора.
+const ListSeqPrefetch = 2
+
+// listPage результат запроса одной страницы списка.
+type listPage[T any] struct {
+	list *List[T]
+	err  error
+}
+
+// withParams возвращает новый срез параметров, не изменяя исходный.
+func withParams(params []func(*Params), extra ...func(*Params)) []func(*Params) {
+	return append(slices.Clip(params), extra...)
+}
+
+// pageSize возвращает размер страницы для постраничного получения объектов.
+//
+// При использовании expand или получении остатков в позициях документов ([WithStockFiled])
+// размер страницы не может превышать 100 элементов.
+func pageSize(params []func(*Params)) int {
+	if p := ApplyParams(params); len(p.Expand) > 0 || p.Fields == "stock" {
+		return 100
+	}
+	return MaxPositions
+}
+
+// stableEntity описывает методы объекта, необходимые для получения списка в режиме [WithStablePagination].
+type stableEntity interface {
+	GetID() string
+	GetUpdated() time.Time
+}
+
+// stableListSeq возвращает итератор, который запрашивает объекты окнами по полям updated и id.
+//
+// Каждое следующее окно запрашивается с фильтром updated>=момент последнего полученного объекта.
+// Если все объекты окна имеют одинаковый момент обновления, следующее окно запрашивается со смещением
+// внутри этого момента. Уже полученные объекты с моментом обновления, равным курсору, пропускаются по ID.
+//
+// Если T не реализует методы GetID и GetUpdated, итератор сразу возвращает ошибку.
+func stableListSeq[T any](ctx context.Context, client *Client, path string, params []func(*Params)) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		if _, ok := any((*T)(nil)).(stableEntity); !ok {
+			yield(nil, fmt.Errorf("stable pagination: %T does not provide GetID and GetUpdated", (*T)(nil)))
+			return
+		}
+
+		var (
+			perPage = pageSize(params)
+			seen    = make(map[string]struct{})
+			cursor  time.Time
+			offset  int
+		)
+
+		for {
+			_params := withParams(params,
+				func(params *Params) { params.Order = nil },
+				WithOrderAsc("updated", "id"),
+				WithLimit(perPage),
+				WithOffset(offset),
+			)
+			if !cursor.IsZero() {
+				_params = append(_params, WithFilterGreaterOrEquals("updated", cursor.In(client.Location()).Format(TimestampFormat)))
+			}
+
+			list, _, err := NewRequestBuilder[List[T]](client, path).SetParams(_params).Get(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			last := cursor
+			for _, row := range list.Rows {
+				entity := any(row).(stableEntity)
+
+				// объекты с меньшим моментом обновления в следующих окнах не запрашиваются
+				if updated := entity.GetUpdated(); !updated.Equal(last) {
+					clear(seen)
+					last = updated
+				}
+
+				if _, ok := seen[entity.GetID()]; ok {
+					continue
+				}
+				seen[entity.GetID()] = struct{}{}
+
+				if !yield(row, nil) {
+					return
+				}
+			}
+
+			if len(list.Rows) < perPage {
+				return
+			}
+
+			if last.Equal(cursor) {
+				offset += perPage
+			} else {
+				cursor, offset = last, 0
+			}
+		}
+	}
+}
+
+// listSeq возвращает итератор, который постранично запрашивает объекты по мере перебора.
+//
+// Одновременно запрашивается не более [ListSeqPrefetch] страниц вперёд с учётом ограничений клиента.
+// При выходе из цикла перебора оставшиеся запросы отменяются.
+// Ошибка запроса передаётся в цикл перебора, после чего перебор завершается.
+func listSeq[T any](ctx context.Context, client *Client, path string, params []func(*Params)) iter.Seq2[*T, error] {
+	if ApplyParams(params).stable {
+		return stableListSeq[T](ctx, client, path, params)
+	}
+
+	return func(yield func(*T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		perPage := pageSize(params)
+		offset := ApplyParams(params).Offset
+
+		first, _, err := NewRequestBuilder[List[T]](client, path).SetParams(withParams(params, WithLimit(perPage), WithOffset(offset))).Get(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for _, row := range first.Rows {
+			if !yield(row, nil) {
+				return
+			}
+		}
+
+		pages := make(chan chan listPage[T], ListSeqPrefetch)
+
+		go func() {
+			defer close(pages)
+
+			for offset += perPage; offset < first.Meta.Size; offset += perPage {
+				page := make(chan listPage[T], 1)
+
+				select {
+				case pages <- page:
+				case <-ctx.Done():
+					return
+				}
+
+				go func(params []func(*Params)) {
+					list, _, err := NewRequestBuilder[List[T]](client, path).SetParams(params).Get(ctx)
+					page <- listPage[T]{list, err}
+				}(withParams(params, WithLimit(perPage), WithOffset(offset)))
+			}
+		}()
+
+		for page := range pages {
+			result := <-page
+			if result.err != nil {
+				yield(nil, result.err)
+				return
+			}
+
+			for _, row := range result.list.Rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// asyncListSeq возвращает итератор по объектам, полученным асинхронной задачей.
+//
+// Создаёт асинхронную задачу (см. [RequestBuilder.Async]), занимая место в очереди [MaxAsyncTasks] на время её выполнения,
+// ожидает её завершения и читает объекты из тела ответа по ссылке на результат по одному, не загружая ответ в память целиком.
+// При отмене контекста до завершения задачи задача отменяется.
+func asyncListSeq[T any](ctx context.Context, client *Client, path string, params []func(*Params)) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		service, _, err := NewRequestBuilder[List[T]](client, path).SetParams(params).async(ctx)
+		if err == nil {
+			err = service.await(ctx, AsyncWaitOptions{})
+
+			// место в очереди занято только на время выполнения задачи
+			service.release()
+		}
+
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		body, _, err := NewRequestBuilder[any](client, service.ResultURL()).Stream(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer body.Close()
+
+		for row, err := range decodeRows[T](body) {
+			row = ConvertTimestamps(row, Location(), client.Location())
+			if !yield(row, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// decodeRows возвращает итератор по элементам поля rows объекта списка в формате JSON.
+//
+// Элементы декодируются по мере чтения, остальные поля объекта пропускаются.
+func decodeRows[T any](r io.Reader) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		decoder := json.NewDecoder(r)
+
+		if err := expectDelim(decoder, '{'); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for decoder.More() {
+			token, err := decoder.Token()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if token != "rows" {
+				var skip json.RawMessage
+				if err := decoder.Decode(&skip); err != nil {
+					yield(nil, err)
+					return
+				}
+				continue
+			}
+
+			if err := expectDelim(decoder, '['); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for decoder.More() {
+				row := new(T)
+				if err := decoder.Decode(row); err != nil {
+					yield(nil, err)
+					return
+				}
+				if !yield(row, nil) {
+					return
+				}
+			}
+
+			if err := expectDelim(decoder, ']'); err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+	}
+}
+
+// expectDelim читает следующий токен и возвращает ошибку, если он не является разделителем delim.
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("decode rows: expected %s, got %v", delim, token)
+	}
+	return nil
+}
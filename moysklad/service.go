@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -455,6 +456,21 @@ type ServiceService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Service], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех услуг.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Service, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех услуг.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Service]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех услуг асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Service, error]
+
 	// Create выполняет запрос на создание услуги.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование услуги)
@@ -465,13 +481,13 @@ type ServiceService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение услуг.
 	// Изменяемые услуги должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список услуг и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых услуг.
-	CreateUpdateMany(ctx context.Context, serviceList Slice[Service], params ...func(*Params)) (*Slice[Service], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых услуг и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, serviceList Slice[Service], params ...func(*Params)) (*BulkResult[Service], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление услуг.
 	// Принимает контекст и множество услуг.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Service) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Service) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление услуги по ID.
 	// Принимает контекст и ID услуги.
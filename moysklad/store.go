@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -447,6 +448,21 @@ type StoreService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Store], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех складов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Store, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех складов.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Store]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех складов асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Store, error]
+
 	// Create выполняет запрос на создание склада.
 	// Обязательные поля для заполнения:
 	//	- name (Наименования склада)
@@ -457,13 +473,13 @@ type StoreService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение складов.
 	// Изменяемые склады должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список складов и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых складов.
-	CreateUpdateMany(ctx context.Context, storeList Slice[Store], params ...func(*Params)) (*Slice[Store], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых складов и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, storeList Slice[Store], params ...func(*Params)) (*BulkResult[Store], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление складов.
 	// Принимает контекст и множество складов.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Store) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Store) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление склада по ID.
 	// Принимает контекст и ID склада.
@@ -498,8 +514,8 @@ type StoreService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -513,8 +529,8 @@ type StoreService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetByID выполняет запрос на получение отдельного склада по ID.
 	// Принимает контекст, ID склада и опционально объект параметров запроса Params.
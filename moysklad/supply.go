@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -38,7 +39,7 @@ type Supply struct {
 	Payments            Slice[Payment]             `json:"payments,omitempty"`            // Массив ссылок на связанные платежи
 	Agent               *Agent                     `json:"agent,omitempty"`               // Метаданные контрагента
 	IncomingNumber      *string                    `json:"incomingNumber,omitempty"`      // Входящий номер
-	PayedSum            *float64                   `json:"payedSum,omitempty"`            // Сумма входящих платежей по Приемке
+	PayedSum            *Money                     `json:"payedSum,omitempty"`            // Сумма входящих платежей по Приемке
 	Positions           *MetaArray[SupplyPosition] `json:"positions,omitempty"`           // Метаданные позиций Приемки
 	Printed             *bool                      `json:"printed,omitempty"`             // Напечатан ли документ
 	Project             *NullValue[Project]        `json:"project,omitempty"`             // Метаданные проекта
@@ -47,12 +48,12 @@ type Supply struct {
 	Shared              *bool                      `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]          `json:"state,omitempty"`               // Метаданные статуса Приемки
 	Store               *Store                     `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                   `json:"sum,omitempty"`                 // Сумма Приемки в копейках
+	Sum                 *Money                     `json:"sum,omitempty"`                 // Сумма Приемки в копейках
 	SyncID              *string                    `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp                 `json:"updated,omitempty"`             // Момент последнего обновления Приемки
 	VatEnabled          *bool                      `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
 	VatIncluded         *bool                      `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	VatSum              *float64                   `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Money                     `json:"vatSum,omitempty"`              // Сумма НДС
 	PurchaseOrder       *PurchaseOrder             `json:"purchaseOrder,omitempty"`       // Ссылка на связанный заказ поставщику
 	FactureIn           *FactureIn                 `json:"factureIn,omitempty"`           // Ссылка на Счет-фактуру полученный, с которым связана эта Приемка
 	InvoicesIn          Slice[InvoiceIn]           `json:"invoicesIn,omitempty"`          // Массив ссылок на связанные счета поставщиков
@@ -80,6 +81,13 @@ func (supply Supply) AsTaskOperation() *TaskOperation {
 	return &TaskOperation{Meta: supply.Meta}
 }
 
+// CalculateTotals реализует интерфейс [TotalsCalculator].
+//
+// Рассчитывает суммы позиций и документа локально, без запроса к серверу (см. [CalculateTotals]).
+func (supply Supply) CalculateTotals() (*DocumentTotals, error) {
+	return CalculateTotals(supply.GetPositions().Rows, supply.GetVatEnabled(), supply.GetVatIncluded())
+}
+
 // AsOperationOut реализует интерфейс [OperationOutConverter].
 func (supply Supply) AsOperationOut() *Operation {
 	return supply.AsOperation()
@@ -202,7 +210,7 @@ func (supply Supply) GetIncomingNumber() string {
 
 // GetPayedSum возвращает Сумму входящих платежей по Приемке.
 func (supply Supply) GetPayedSum() float64 {
-	return Deref(supply.PayedSum)
+	return Deref(supply.PayedSum).Float()
 }
 
 // GetPositions возвращает Метаданные позиций Приемки.
@@ -247,7 +255,7 @@ func (supply Supply) GetStore() Store {
 
 // GetSum возвращает Сумму Приемки в копейках.
 func (supply Supply) GetSum() float64 {
-	return Deref(supply.Sum)
+	return Deref(supply.Sum).Float()
 }
 
 // GetSyncID возвращает ID синхронизации.
@@ -272,7 +280,7 @@ func (supply Supply) GetVatIncluded() bool {
 
 // GetVatSum возвращает Сумму НДС.
 func (supply Supply) GetVatSum() float64 {
-	return Deref(supply.VatSum)
+	return Deref(supply.VatSum).Float()
 }
 
 // GetPurchaseOrder возвращает Ссылку на связанный заказ поставщику.
@@ -580,12 +588,12 @@ type SupplyPosition struct {
 	AccountID     *string             `json:"accountId,omitempty"`     // ID учётной записи
 	ID            *string             `json:"id,omitempty"`            // ID позиции
 	Assortment    *AssortmentPosition `json:"assortment,omitempty"`    // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
-	Price         *float64            `json:"price,omitempty"`         // Цена товара/услуги в копейках
+	Price         *Money              `json:"price,omitempty"`         // Цена товара/услуги в копейках
 	GTD           *GTD                `json:"gtd,omitempty"`           // ГТД
 	Slot          *Slot               `json:"slot,omitempty"`          // Ячейка на складе
 	Stock         *Stock              `json:"stock,omitempty"`         // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	VatEnabled    *bool               `json:"vatEnabled,omitempty"`    // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
-	Overhead      *float64            `json:"overhead,omitempty"`      // Накладные расходы. Если Позиции Приемки не заданы, то накладные расходы нельзя задать.
+	Overhead      *Money              `json:"overhead,omitempty"`      // Накладные расходы. Если Позиции Приемки не заданы, то накладные расходы нельзя задать.
 	Vat           *int                `json:"vat,omitempty"`           // НДС, которым облагается текущая позиция
 	TrackingCodes Slice[TrackingCode] `json:"trackingCodes,omitempty"` // Коды маркировки товаров и транспортных упаковок
 	Things        Slice[string]       `json:"things,omitempty"`        // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута.
@@ -635,7 +643,7 @@ func (supplyPosition SupplyPosition) GetPack() Pack {
 
 // GetPrice возвращает Цену товара/услуги в копейках.
 func (supplyPosition SupplyPosition) GetPrice() float64 {
-	return Deref(supplyPosition.Price)
+	return Deref(supplyPosition.Price).Float()
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в позиции.
@@ -666,7 +674,7 @@ func (supplyPosition SupplyPosition) GetTrackingCodes() Slice[TrackingCode] {
 
 // GetOverhead возвращает Накладные расходы.
 func (supplyPosition SupplyPosition) GetOverhead() float64 {
-	return Deref(supplyPosition.Overhead)
+	return Deref(supplyPosition.Overhead).Float()
 }
 
 // GetVat возвращает НДС, которым облагается текущая позиция.
@@ -685,6 +693,11 @@ func (supplyPosition SupplyPosition) GetVatEnabled() bool {
 	return Deref(supplyPosition.VatEnabled)
 }
 
+// LookupVatEnabled возвращает флаг учёта НДС и true, если флаг указан.
+func (supplyPosition SupplyPosition) LookupVatEnabled() (bool, bool) {
+	return Deref(supplyPosition.VatEnabled), supplyPosition.VatEnabled != nil
+}
+
 // GetStock возвращает Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`).
 func (supplyPosition SupplyPosition) GetStock() Stock {
 	return Deref(supplyPosition.Stock)
@@ -730,7 +743,7 @@ func (supplyPosition *SupplyPosition) SetPack(pack *Pack) *SupplyPosition {
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
 func (supplyPosition *SupplyPosition) SetPrice(price float64) *SupplyPosition {
-	supplyPosition.Price = &price
+	supplyPosition.Price = NewMoney(MoneyFromFloat(price))
 	return supplyPosition
 }
 
@@ -804,6 +817,21 @@ type SupplyService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Supply], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех приемок.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Supply, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех приемок.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Supply]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех приемок асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Supply, error]
+
 	// Create выполняет запрос на создание приемки.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -816,13 +844,13 @@ type SupplyService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение приемок.
 	// Изменяемые приемки должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список приемок и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых приемок.
-	CreateUpdateMany(ctx context.Context, supplyList Slice[Supply], params ...func(*Params)) (*Slice[Supply], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых приемок и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, supplyList Slice[Supply], params ...func(*Params)) (*BulkResult[Supply], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление приемок.
 	// Принимает контекст и множество приемок.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Supply) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Supply) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление приемки по ID.
 	// Принимает контекст и ID приемки.
@@ -869,6 +897,11 @@ type SupplyService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[SupplyPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для постраничного получения всех позиций документа.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*SupplyPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -886,8 +919,8 @@ type SupplyService interface {
 
 	// CreatePositionMany выполняет запрос на массовое добавление позиций документа.
 	// Принимает контекст, ID документа и множество позиций.
-	// Возвращает список добавленных позиций.
-	CreatePositionMany(ctx context.Context, id string, positions ...*SupplyPosition) (*Slice[SupplyPosition], *resty.Response, error)
+	// Возвращает объект BulkResult со списком добавленных позиций и ошибками по каждому элементу.
+	CreatePositionMany(ctx context.Context, id string, positions ...*SupplyPosition) (*BulkResult[SupplyPosition], *resty.Response, error)
 
 	// DeletePosition выполняет запрос на удаление позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
@@ -899,6 +932,12 @@ type SupplyService interface {
 	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
 	DeletePositionMany(ctx context.Context, id string, positions ...*SupplyPosition) (*DeleteManyResponse, *resty.Response, error)
 
+	// SyncPositions выполняет синхронизацию позиций документа с минимальным числом изменений.
+	// Принимает контекст, ID документа, желаемые позиции и функцию, возвращающую ключ сопоставления позиций.
+	// Если функция не передана (nil), позиции сопоставляются по ссылке на товар/услугу/серию/модификацию.
+	// Возвращает объект PositionSyncResult, содержащий созданные, изменённые и удалённые позиции.
+	SyncPositions(ctx context.Context, id string, desired Slice[SupplyPosition], key func(position *SupplyPosition) string) (*PositionSyncResult[SupplyPosition], error)
+
 	// GetPositionTrackingCodeList выполняет запрос на получение кодов маркировки позиции документа.
 	// Принимает контекст, ID документа и ID позиции.
 	// Возвращает объект List.
@@ -932,8 +971,8 @@ type SupplyService interface {
 	// CreateUpdateAttributeMany выполняет запрос на массовое создание и/или изменение доп полей.
 	// Изменяемые доп поля должны содержать идентификатор в виде метаданных.
 	// Принимает контекст и множество доп полей.
-	// Возвращает список созданных и/или изменённых доп полей.
-	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*Slice[Attribute], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых доп полей и ошибками по каждому элементу.
+	CreateUpdateAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[Attribute], *resty.Response, error)
 
 	// UpdateAttribute выполняет запрос на изменения доп поля.
 	// Принимает контекст, ID доп поля и доп поле.
@@ -947,8 +986,8 @@ type SupplyService interface {
 
 	// DeleteAttributeMany выполняет запрос на массовое удаление доп полей.
 	// Принимает контекст и множество доп полей.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteAttributeMany(ctx context.Context, attributes ...*Attribute) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// GetPublicationList выполняет запрос на получение списка публикаций.
 	// Принимает контекст и ID документа.
@@ -1027,8 +1066,8 @@ type SupplyService interface {
 
 	// CreateUpdateStateMany выполняет запрос на массовое создание и/или изменение статусов документа.
 	// Принимает контекст и множество статусов.
-	// Возвращает список созданных и/или изменённых статусов.
-	CreateUpdateStateMany(ctx context.Context, states ...*State) (*Slice[State], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых статусов и ошибками по каждому элементу.
+	CreateUpdateStateMany(ctx context.Context, states ...*State) (*BulkResult[State], *resty.Response, error)
 
 	// DeleteState выполняет запрос на удаление статуса документа.
 	// Принимает контекст и ID статуса.
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 	"time"
 )
 
@@ -839,6 +840,21 @@ type TaskService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Task], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех задач.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Task, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех задач.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Task]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех задач асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Task, error]
+
 	// Create выполняет запрос на создание задачи.
 	// Создать новую задачу. Для создания новых задач необходима активная тарифная опция CRM.
 	// Обязательные поля для заполнения:
@@ -851,13 +867,13 @@ type TaskService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение задач.
 	// Изменяемые задачи должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список задач и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых задач.
-	CreateUpdateMany(ctx context.Context, taskList Slice[Task], params ...func(*Params)) (*Slice[Task], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых задач и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, taskList Slice[Task], params ...func(*Params)) (*BulkResult[Task], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление задач.
 	// Принимает контекст и множество задач.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Task) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Task) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление задачи по ID.
 	// Принимает контекст и ID задачи.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -160,6 +161,21 @@ type TaxRateService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[TaxRate], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех налоговых ставок.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*TaxRate, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех налоговых ставок.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[TaxRate]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех налоговых ставок асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*TaxRate, error]
+
 	// Create выполняет запрос на создание налоговой ставки.
 	// Обязательные поля для заполнения:
 	//	- rate (Значение налоговой ставки)
@@ -170,13 +186,13 @@ type TaxRateService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение налоговых ставок.
 	// Изменяемые налоговые ставки должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список налоговых ставок и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых налоговых ставок.
-	CreateUpdateMany(ctx context.Context, taxRateList Slice[TaxRate], params ...func(*Params)) (*Slice[TaxRate], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых налоговых ставок и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, taxRateList Slice[TaxRate], params ...func(*Params)) (*BulkResult[TaxRate], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление налоговых ставок.
 	// Принимает контекст и множество налоговых ставок.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*TaxRate) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*TaxRate) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление налоговой ставки по ID.
 	// Принимает контекст и ID налоговой ставки.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Thing Серийный номер
@@ -83,6 +84,21 @@ type ThingService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Thing], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех серийных номеров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Thing, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех серийных номеров.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Thing]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех серийных номеров асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Thing, error]
+
 	// GetByID выполняет запрос на получение отдельного серийного номера по ID.
 	// Принимает контекст, ID серийного номера и опционально объект параметров запроса Params.
 	// Возвращает найденный серийный номер.
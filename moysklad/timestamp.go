@@ -1,7 +1,10 @@
 package moysklad
 
 import (
+	"bytes"
 	"encoding/json"
+	"reflect"
+	"sync/atomic"
 	"time"
 )
 
@@ -12,8 +15,60 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/#mojsklad-json-api-obschie-swedeniq-format-daty-i-wremeni
 const TimestampFormat = "2006-01-02 15:04:05.000"
 
-// Timestamp represents a time that can be unmarshalled from a JSON string
-// formatted as either an TimestampFormat.
+// DefaultLocationName часовой пояс учётной записи по умолчанию.
+const DefaultLocationName = "Europe/Moscow"
+
+// location часовой пояс учётной записи.
+var location atomic.Pointer[time.Location]
+
+func init() {
+	loc, err := time.LoadLocation(DefaultLocationName)
+	if err != nil {
+		// база часовых поясов недоступна, с 2014 года в Москве не используется летнее время
+		loc = time.FixedZone("MSK", 3*60*60)
+	}
+	location.Store(loc)
+}
+
+// Location возвращает часовой пояс по умолчанию.
+//
+// API МойСклад принимает и возвращает дату и время без указания часового пояса, в часовом поясе учётной записи.
+// По умолчанию используется часовой пояс [DefaultLocationName]. Часовой пояс учётной записи клиента
+// задаётся параметром Config.Location.
+func Location() *time.Location {
+	return location.Load()
+}
+
+// SetLocation устанавливает часовой пояс по умолчанию.
+//
+// Часовой пояс по умолчанию используется при разборе и формировании [Timestamp] вне клиента
+// (например, в [json.Marshal]), в функциях [FormatTime] и [ParseTime], а также клиентами,
+// для которых не указан Config.Location.
+func SetLocation(loc *time.Location) {
+	if loc != nil {
+		location.Store(loc)
+	}
+}
+
+// FormatTime возвращает дату и время t в часовом поясе по умолчанию в формате [TimestampFormat].
+func FormatTime(t time.Time) string {
+	return t.In(Location()).Format(TimestampFormat)
+}
+
+// ParseTime разбирает дату и время в часовом поясе по умолчанию.
+//
+// Миллисекунды могут отсутствовать.
+func ParseTime(value string) (time.Time, error) {
+	return time.ParseInLocation(time.DateTime, value, Location())
+}
+
+// Timestamp Дата и время в часовом поясе учётной записи.
+//
+// Разбирается из строки в формате [TimestampFormat] с миллисекундами или без них.
+// Пустая строка и null разбираются в нулевое значение, нулевое значение передаётся как null.
+//
+// Методы MarshalJSON и UnmarshalJSON используют часовой пояс по умолчанию ([Location]),
+// клиент переносит значения в часовой пояс учётной записи (см. [ConvertTimestamps]).
 type Timestamp time.Time
 
 // NewTimestamp принимает [time.Time] и возвращает [Timestamp].
@@ -32,14 +87,154 @@ func (timestamp Timestamp) Time() time.Time {
 	return (time.Time)(timestamp)
 }
 
+// IsZero возвращает true, если дата и время не заданы.
+func (timestamp Timestamp) IsZero() bool {
+	return timestamp.Time().IsZero()
+}
+
 // MarshalJSON реализует интерфейс [json.Marshaler].
+//
+// Дата и время передаются в часовом поясе по умолчанию с миллисекундами.
 func (timestamp Timestamp) MarshalJSON() ([]byte, error) {
-	return json.Marshal((time.Time)(timestamp).Format("2006-01-02 15:04:05"))
+	if timestamp.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(FormatTime(timestamp.Time()))
 }
 
 // UnmarshalJSON реализует интерфейс [json.Unmarshaler].
-func (timestamp *Timestamp) UnmarshalJSON(data []byte) (err error) {
-	t, err := time.Parse(`"`+TimestampFormat+`"`, string(data))
+func (timestamp *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		*timestamp = Timestamp{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	t, err := ParseTime(value)
+	if err != nil {
+		return err
+	}
+
 	*timestamp = Timestamp(t)
-	return
+	return nil
+}
+
+var timestampType = reflect.TypeFor[Timestamp]()
+
+// ConvertTimestamps возвращает копию value, в которой значения [Timestamp] перенесены из часового пояса from
+// в часовой пояс to с сохранением даты и времени на часах.
+//
+// Используется клиентом, часовой пояс учётной записи которого отличается от часового пояса по умолчанию:
+// значения, разобранные [Timestamp.UnmarshalJSON], переносятся из [Location] в часовой пояс учётной записи,
+// а перед передачей – обратно. Обрабатываются экспортируемые поля структур, указатели, интерфейсы,
+// срезы, массивы и словари. Исходное значение не изменяется; если value не содержит [Timestamp], возвращается value.
+func ConvertTimestamps[T any](value T, from, to *time.Location) T {
+	if from == to || from == nil || to == nil {
+		return value
+	}
+
+	converted, ok := convertTimestamps(reflect.ValueOf(&value).Elem(), from, to)
+	if !ok {
+		return value
+	}
+	return converted.Interface().(T)
+}
+
+// convertTimestamps возвращает копию v с перенесёнными значениями [Timestamp] и true, если они были изменены.
+func convertTimestamps(v reflect.Value, from, to *time.Location) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == timestampType {
+			timestamp := v.Interface().(Timestamp)
+			if timestamp.IsZero() {
+				return v, false
+			}
+			wall := timestamp.Time().In(from)
+			t := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), to)
+			return reflect.ValueOf(Timestamp(t)), true
+		}
+
+		var out reflect.Value
+		for i := range v.NumField() {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			field, ok := convertTimestamps(v.Field(i), from, to)
+			if !ok {
+				continue
+			}
+			if !out.IsValid() {
+				out = reflect.New(v.Type()).Elem()
+				out.Set(v)
+			}
+			out.Field(i).Set(field)
+		}
+		if out.IsValid() {
+			return out, true
+		}
+
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			break
+		}
+		elem, ok := convertTimestamps(v.Elem(), from, to)
+		if !ok {
+			break
+		}
+		if v.Kind() == reflect.Pointer {
+			out := reflect.New(v.Type().Elem())
+			out.Elem().Set(elem)
+			return out, true
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(elem)
+		return out, true
+
+	case reflect.Slice, reflect.Array:
+		var out reflect.Value
+		for i := range v.Len() {
+			elem, ok := convertTimestamps(v.Index(i), from, to)
+			if !ok {
+				continue
+			}
+			if !out.IsValid() {
+				if v.Kind() == reflect.Slice {
+					out = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+					reflect.Copy(out, v)
+				} else {
+					out = reflect.New(v.Type()).Elem()
+					out.Set(v)
+				}
+			}
+			out.Index(i).Set(elem)
+		}
+		if out.IsValid() {
+			return out, true
+		}
+
+	case reflect.Map:
+		var out reflect.Value
+		for iter := v.MapRange(); iter.Next(); {
+			elem, ok := convertTimestamps(iter.Value(), from, to)
+			if !ok {
+				continue
+			}
+			if !out.IsValid() {
+				out = reflect.MakeMapWithSize(v.Type(), v.Len())
+				for copyIter := v.MapRange(); copyIter.Next(); {
+					out.SetMapIndex(copyIter.Key(), copyIter.Value())
+				}
+			}
+			out.SetMapIndex(iter.Key(), elem)
+		}
+		if out.IsValid() {
+			return out, true
+		}
+	}
+
+	return v, false
 }
@@ -0,0 +1,126 @@
+package moysklad_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/filter"
+)
+
+func TestTimestampJSON(t *testing.T) {
+	loc := moysklad.Location()
+
+	tests := []struct {
+		data string
+		want time.Time
+		out  string
+	}{
+		{`null`, time.Time{}, `null`},
+		{`""`, time.Time{}, `null`},
+		{`"2024-03-01 12:30:45.123"`, time.Date(2024, 3, 1, 12, 30, 45, 123e6, loc), `"2024-03-01 12:30:45.123"`},
+		{`"2024-03-01 12:30:45"`, time.Date(2024, 3, 1, 12, 30, 45, 0, loc), `"2024-03-01 12:30:45.000"`},
+	}
+
+	for _, test := range tests {
+		var timestamp moysklad.Timestamp
+		if err := json.Unmarshal([]byte(test.data), &timestamp); err != nil {
+			t.Fatalf("%s: %v", test.data, err)
+		}
+		if !timestamp.Time().Equal(test.want) {
+			t.Fatalf("%s: got %s, want %s", test.data, timestamp.Time(), test.want)
+		}
+
+		data, err := json.Marshal(timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != test.out {
+			t.Fatalf("%s: got %s, want %s", test.data, data, test.out)
+		}
+	}
+}
+
+func TestConvertTimestamps(t *testing.T) {
+	from, to := time.FixedZone("UTC+3", 3*60*60), time.FixedZone("UTC+5", 5*60*60)
+	moment := time.Date(2024, 3, 1, 12, 0, 0, 0, from)
+
+	order := &moysklad.CustomerOrder{Moment: moysklad.NewTimestamp(moment), Name: moysklad.String("1")}
+	converted := moysklad.ConvertTimestamps(order, from, to)
+
+	if want := time.Date(2024, 3, 1, 12, 0, 0, 0, to); !converted.Moment.Time().Equal(want) {
+		t.Fatalf("got %s, want %s", converted.Moment.Time(), want)
+	}
+	if converted == order || !order.Moment.Time().Equal(moment) {
+		t.Fatal("got source value changed, want copy")
+	}
+	if converted.GetName() != "1" {
+		t.Fatalf("got name %q, want 1", converted.GetName())
+	}
+}
+
+func TestClientLocation(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		queries []string
+		bodies  []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		queries = append(queries, r.URL.Query().Get("filter")+"|"+r.URL.Query().Get("momentFrom"))
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"1","moment":"2024-03-01 12:00:00.000"}`))
+	}))
+	defer server.Close()
+
+	moscow, yekaterinburg := time.FixedZone("UTC+3", 3*60*60), time.FixedZone("UTC+5", 5*60*60)
+	moment := time.Date(2024, 3, 1, 7, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		loc   *time.Location
+		wall  string
+		query string
+	}{
+		{moscow, "2024-03-01 10:00:00.000", "moment>2024-03-01 10:00:00.000|2024-03-01 10:00:00"},
+		{yekaterinburg, "2024-03-01 12:00:00.000", "moment>2024-03-01 12:00:00.000|2024-03-01 12:00:00"},
+	} {
+		mu.Lock()
+		queries, bodies = nil, nil
+		mu.Unlock()
+
+		// клиенты учётных записей с разными часовыми поясами в одном процессе
+		client := moysklad.New(moysklad.Config{BaseURL: server.URL, Token: "test", Location: test.loc})
+
+		order, _, err := client.Entity().CustomerOrder().Create(ctx, &moysklad.CustomerOrder{Moment: moysklad.NewTimestamp(moment)},
+			moysklad.WithFilterExpr(filter.Field("moment").Gt(moment)),
+			moysklad.WithMomentFrom(moment),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want := time.Date(2024, 3, 1, 12, 0, 0, 0, test.loc); !order.Moment.Time().Equal(want) {
+			t.Fatalf("%s: got moment %s, want %s", test.loc, order.Moment.Time(), want)
+		}
+		if !strings.Contains(bodies[0], test.wall) {
+			t.Fatalf("%s: got body %s, want moment %s", test.loc, bodies[0], test.wall)
+		}
+		if queries[0] != test.query {
+			t.Fatalf("%s: got query %s, want %s", test.loc, queries[0], test.query)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrEmptyToken возвращается источником токена, если токен не задан.
+var ErrEmptyToken = errors.New("moysklad: empty token")
+
+// TokenSourceError ошибка получения токена из [TokenSource].
+//
+// Запрос, для которого не удалось получить токен, не повторяется политикой [RetryPolicy],
+// даже если исходная ошибка является сетевой.
+type TokenSourceError struct {
+	Err error // Ошибка источника токена
+}
+
+func (tokenSourceError *TokenSourceError) Error() string {
+	return "moysklad: token source: " + tokenSourceError.Err.Error()
+}
+
+// Unwrap возвращает ошибку источника токена.
+func (tokenSourceError *TokenSourceError) Unwrap() error {
+	return tokenSourceError.Err
+}
+
+// TokenSource источник токена для аутентификации запросов.
+//
+// Токен запрашивается перед каждой попыткой запроса. При получении ответа 401 (Unauthorized)
+// клиент один раз вызывает Refresh и повторяет запрос с новым токеном.
+// Ошибки Token и Refresh возвращаются клиентом обёрнутыми в [TokenSourceError].
+//
+// Реализации должны быть безопасны для параллельного вызова.
+type TokenSource interface {
+	// Token возвращает действующий токен.
+	Token(ctx context.Context) (string, error)
+
+	// Refresh возвращает новый токен взамен отклонённого токена rejected.
+	// Если токен уже был обновлён другим запросом, возвращает актуальный токен.
+	Refresh(ctx context.Context, rejected string) (string, error)
+}
+
+// staticTokenSource источник, возвращающий постоянный токен.
+type staticTokenSource string
+
+// StaticTokenSource возвращает [TokenSource] с постоянным токеном token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+func (source staticTokenSource) Token(context.Context) (string, error) {
+	if source == "" {
+		return "", ErrEmptyToken
+	}
+	return string(source), nil
+}
+
+func (source staticTokenSource) Refresh(ctx context.Context, _ string) (string, error) {
+	return source.Token(ctx)
+}
+
+// passwordTokenSource источник, получающий токен по логину и паролю.
+type passwordTokenSource struct {
+	client *Client
+	mu     sync.Mutex
+	token  string
+}
+
+// NewPasswordTokenSource возвращает [TokenSource], который получает токен по логину и паролю
+// (см. [SecurityTokenService]) и хранит его до получения ответа 401.
+//
+// Принимает конфигурацию клиента, в которой указаны Username и Password.
+// Остальные параметры конфигурации (например, BaseURL, HTTPClient) используются для запроса токена.
+//
+// # Пример:
+//
+//	client := moysklad.New(moysklad.Config{
+//		TokenSource: moysklad.NewPasswordTokenSource(moysklad.Config{
+//			Username: "admin@company",
+//			Password: "password",
+//		}),
+//	})
+func NewPasswordTokenSource(config Config) TokenSource {
+	config.Token = ""
+	config.TokenSource = nil
+	return &passwordTokenSource{client: New(config)}
+}
+
+func (source *passwordTokenSource) Token(ctx context.Context) (string, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if source.token != "" {
+		return source.token, nil
+	}
+	return source.fetch(ctx)
+}
+
+func (source *passwordTokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if source.token != "" && source.token != rejected {
+		return source.token, nil
+	}
+	return source.fetch(ctx)
+}
+
+// fetch запрашивает новый токен.
+func (source *passwordTokenSource) fetch(ctx context.Context) (string, error) {
+	token, _, err := source.client.Security().GetNewToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get new token: %w", err)
+	}
+	if token == nil || token.AccessToken == "" {
+		return "", ErrEmptyToken
+	}
+
+	source.token = token.AccessToken
+	return source.token, nil
+}
+
+// fileTokenSource источник, читающий токен из файла.
+type fileTokenSource struct {
+	path    string
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFileTokenSource возвращает [TokenSource], который читает токен из файла path.
+//
+// Файл перечитывается при изменении времени модификации или размера, а также при получении ответа 401.
+// Пробельные символы в начале и конце файла отбрасываются.
+func NewFileTokenSource(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+func (source *fileTokenSource) Token(context.Context) (string, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	info, err := os.Stat(source.path)
+	if err != nil {
+		return "", err
+	}
+
+	if source.token != "" && info.ModTime().Equal(source.modTime) && info.Size() == source.size {
+		return source.token, nil
+	}
+	return source.read(info)
+}
+
+func (source *fileTokenSource) Refresh(context.Context, string) (string, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	info, err := os.Stat(source.path)
+	if err != nil {
+		return "", err
+	}
+	return source.read(info)
+}
+
+// read читает токен из файла с информацией info.
+func (source *fileTokenSource) read(info os.FileInfo) (string, error) {
+	data, err := os.ReadFile(source.path)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("%w: file %s", ErrEmptyToken, source.path)
+	}
+
+	source.token, source.modTime, source.size = token, info.ModTime(), info.Size()
+	return token, nil
+}
+
+// envTokenSource источник, читающий токен из переменной окружения.
+type envTokenSource string
+
+// NewEnvTokenSource возвращает [TokenSource], который читает токен из переменной окружения name перед каждым запросом.
+func NewEnvTokenSource(name string) TokenSource {
+	return envTokenSource(name)
+}
+
+func (source envTokenSource) Token(context.Context) (string, error) {
+	token := strings.TrimSpace(os.Getenv(string(source)))
+	if token == "" {
+		return "", fmt.Errorf("%w: environment variable %s", ErrEmptyToken, string(source))
+	}
+	return token, nil
+}
+
+func (source envTokenSource) Refresh(ctx context.Context, _ string) (string, error) {
+	return source.Token(ctx)
+}
@@ -0,0 +1,159 @@
+package moysklad
+
+import (
+	"fmt"
+)
+
+// TotalsPosition описывает позицию документа, по которой рассчитываются суммы.
+//
+// Реализуется позициями Заказа покупателя, Отгрузки и Приёмки.
+type TotalsPosition interface {
+	GetQuantity() float64 // Количество товаров/услуг в позиции
+	GetPrice() float64    // Цена товара/услуги в копейках
+	GetDiscount() float64 // Процент скидки или наценки
+	GetVat() int          // НДС, которым облагается позиция
+
+	// LookupVatEnabled возвращает флаг учёта НДС позиции и true, если флаг указан.
+	LookupVatEnabled() (bool, bool)
+}
+
+// TotalsCalculator описывает документ, суммы которого можно рассчитать локально.
+//
+// Реализуется документами [CustomerOrder], [Demand] и [Supply].
+type TotalsCalculator interface {
+	GetSum() float64
+	GetVatSum() float64
+	CalculateTotals() (*DocumentTotals, error)
+}
+
+// PositionTotals Суммы позиции документа.
+type PositionTotals struct {
+	Index    int   // Порядковый номер позиции в документе
+	Vat      int   // Ставка НДС, применённая к позиции
+	Amount   Money // Сумма позиции без учёта скидки (цена × количество)
+	Discount Money // Сумма скидки (отрицательная для наценки)
+	Sum      Money // Сумма позиции с учётом скидки и НДС
+	VatSum   Money // Сумма НДС позиции
+}
+
+// DocumentTotals Суммы документа, рассчитанные по позициям.
+type DocumentTotals struct {
+	Positions []PositionTotals // Суммы позиций
+	Sum       Money            // Сумма документа с учётом НДС
+	VatSum    Money            // Сумма НДС документа
+}
+
+// CalculateTotals рассчитывает суммы позиций и документа так же, как это делает сервер МойСклад
+// при автозаполнении документа.
+//
+// Сумма позиции равна цене × количество × (100 - скидка) / 100, округлённой до копейки.
+// НДС рассчитывается от округлённой суммы позиции: при vatIncluded = true он выделяется из суммы
+// (сумма × ставка / (100 + ставка)), иначе начисляется сверху (сумма × ставка / 100) и добавляется к сумме позиции.
+// Если НДС документа или позиции выключен (vatEnabled = false), НДС не рассчитывается.
+// Если флаг vatEnabled позиции не указан, НДС позиции рассчитывается при ненулевой ставке.
+// Округление выполняется по правилу [RoundHalfUp] для каждой позиции, суммы документа складываются из сумм позиций.
+// Возвращает [ErrMoneyOverflow], если сумма не помещается в int64 копеек.
+//
+// Для расчёта документ должен содержать все позиции (см. [WithExpand] и [MetaArray.Size]).
+func CalculateTotals[P TotalsPosition](positions Slice[P], vatEnabled, vatIncluded bool) (*DocumentTotals, error) {
+	totals := &DocumentTotals{Positions: make([]PositionTotals, 0, len(positions))}
+
+	for i, position := range positions {
+		if position == nil {
+			continue
+		}
+
+		positionTotals, err := calculatePositionTotals(i, *position, vatEnabled, vatIncluded)
+		if err != nil {
+			return nil, fmt.Errorf("position %d: %w", i, err)
+		}
+		totals.Positions = append(totals.Positions, positionTotals)
+	}
+
+	var err error
+	for _, position := range totals.Positions {
+		if totals.Sum, err = totals.Sum.Add(position.Sum); err != nil {
+			return nil, err
+		}
+		if totals.VatSum, err = totals.VatSum.Add(position.VatSum); err != nil {
+			return nil, err
+		}
+	}
+
+	return totals, nil
+}
+
+// calculatePositionTotals рассчитывает суммы позиции документа.
+func calculatePositionTotals(index int, position TotalsPosition, vatEnabled, vatIncluded bool) (PositionTotals, error) {
+	price := MoneyFromFloat(position.GetPrice())
+	discount := position.GetDiscount()
+
+	var err error
+	totals := PositionTotals{Index: index}
+	if totals.Amount, err = price.MulFloat(position.GetQuantity(), RoundHalfUp); err != nil {
+		return totals, err
+	}
+	if totals.Sum, err = price.MulFloat(position.GetQuantity()*(100-discount)/100, RoundHalfUp); err != nil {
+		return totals, err
+	}
+	if totals.Discount, err = totals.Amount.Sub(totals.Sum); err != nil {
+		return totals, err
+	}
+
+	// у позиций, созданных локально, флаг vatEnabled может быть не указан, тогда НДС учитывается по ставке
+	positionVatEnabled, ok := position.LookupVatEnabled()
+	if !ok {
+		positionVatEnabled = position.GetVat() > 0
+	}
+
+	if vatEnabled && positionVatEnabled {
+		totals.Vat = position.GetVat()
+	}
+
+	if totals.Vat == 0 {
+		return totals, nil
+	}
+
+	if vatIncluded {
+		totals.VatSum, err = totals.Sum.MulFloat(float64(totals.Vat)/float64(100+totals.Vat), RoundHalfUp)
+		return totals, err
+	}
+
+	if totals.VatSum, err = totals.Sum.Percent(float64(totals.Vat), RoundHalfUp); err != nil {
+		return totals, err
+	}
+	totals.Sum, err = totals.Sum.Add(totals.VatSum)
+	return totals, err
+}
+
+// TotalsDiscrepancy Расхождение сумм, рассчитанных локально, с суммами сервера.
+type TotalsDiscrepancy struct {
+	Field  string // Название поля: sum или vatSum
+	Local  Money  // Сумма, рассчитанная локально
+	Server Money  // Сумма сервера
+}
+
+// String реализует интерфейс [fmt.Stringer].
+func (discrepancy TotalsDiscrepancy) String() string {
+	return fmt.Sprintf("%s: local %s, server %s", discrepancy.Field, discrepancy.Local, discrepancy.Server)
+}
+
+// CompareWithServer сравнивает суммы с суммами документа server, полученного в ответ на запрос Evaluate.
+//
+// Суммы документа сравниваются с полями sum и vatSum ответа сервера.
+// Позиции документа в ответе не содержат сумм, поэтому суммы позиций не сравниваются.
+// Возвращает список расхождений или nil, если суммы совпадают.
+func (totals DocumentTotals) CompareWithServer(server TotalsCalculator) []TotalsDiscrepancy {
+	var discrepancies []TotalsDiscrepancy
+
+	compare := func(field string, local, remote Money) {
+		if local.Cmp(remote) != 0 {
+			discrepancies = append(discrepancies, TotalsDiscrepancy{Field: field, Local: local, Server: remote})
+		}
+	}
+
+	compare("sum", totals.Sum, MoneyFromFloat(server.GetSum()))
+	compare("vatSum", totals.VatSum, MoneyFromFloat(server.GetVatSum()))
+
+	return discrepancies
+}
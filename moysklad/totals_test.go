@@ -0,0 +1,62 @@
+package moysklad
+
+import "testing"
+
+func TestCalculateTotalsVatEnabled(t *testing.T) {
+	position := func(vatEnabled *bool) *CustomerOrderPosition {
+		return &CustomerOrderPosition{
+			Quantity:   Float(2),
+			Price:      NewMoney(Kopecks(10000)),
+			Vat:        Int(20),
+			VatEnabled: vatEnabled,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		vatEnabled *bool
+		vatSum     int64
+	}{
+		{"enabled", Bool(true), 4000},
+		{"disabled", Bool(false), 0},
+		{"unset", nil, 4000},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			totals, err := CalculateTotals(Slice[CustomerOrderPosition]{position(test.vatEnabled)}, true, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if totals.VatSum.Amount != test.vatSum {
+				t.Fatalf("got vatSum %d, want %d", totals.VatSum.Amount, test.vatSum)
+			}
+			if totals.Sum.Amount != 20000+test.vatSum {
+				t.Fatalf("got sum %d, want %d", totals.Sum.Amount, 20000+test.vatSum)
+			}
+		})
+	}
+}
+
+func TestCompareWithServer(t *testing.T) {
+	order := CustomerOrder{
+		Positions:  &MetaArray[CustomerOrderPosition]{Rows: Slice[CustomerOrderPosition]{{Quantity: Float(1), Price: NewMoney(Kopecks(10000))}}},
+		VatEnabled: Bool(true),
+	}
+	totals, err := order.CalculateTotals()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	order.Sum = NewMoney(Kopecks(10000))
+	if discrepancies := totals.CompareWithServer(order); discrepancies != nil {
+		t.Fatalf("got discrepancies %v, want none", discrepancies)
+	}
+
+	// сумма сервера сравнивается как есть, без пересчёта по позициям
+	order.Sum = NewMoney(Kopecks(12000))
+	discrepancies := totals.CompareWithServer(order)
+	if len(discrepancies) != 1 || discrepancies[0].Field != "sum" || discrepancies[0].Server.Amount != 12000 {
+		t.Fatalf("got discrepancies %v, want sum mismatch", discrepancies)
+	}
+}
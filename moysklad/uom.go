@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -159,6 +160,21 @@ type UomService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Uom], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех единиц измерения.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Uom, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех единиц измерения.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Uom]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех единиц измерения асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Uom, error]
+
 	// Create выполняет запрос на создание единицы измерения.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование единицы измерения)
@@ -169,13 +185,13 @@ type UomService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение единиц измерения.
 	// Изменяемые единицы измерения должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список единиц измерения и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых единиц измерения.
-	CreateUpdateMany(ctx context.Context, uomList Slice[Uom], params ...func(*Params)) (*Slice[Uom], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых единиц измерения и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, uomList Slice[Uom], params ...func(*Params)) (*BulkResult[Uom], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление единиц измерения.
 	// Принимает контекст и множество единиц измерения.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Uom) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Uom) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление единицы измерения по ID.
 	// Принимает контекст и ID единицы измерения.
@@ -0,0 +1,304 @@
+package moysklad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"net/url"
+)
+
+// MaxFilterLength максимальная длина параметра filter (после URL-кодирования) в одном запросе поиска по ключам.
+const MaxFilterLength = 4000
+
+// KeyFunc ключ, по которому сопоставляются передаваемые и существующие объекты.
+type KeyFunc struct {
+	Field string                  // Поле фильтрации
+	Value func(entity any) string // Возвращает значение ключа объекта. Принимает указатель на объект
+}
+
+var (
+	// KeyExternalCode сопоставление по внешнему коду (externalCode).
+	KeyExternalCode = KeyFunc{Field: "externalCode", Value: func(entity any) string {
+		if owner, ok := entity.(interface{ GetExternalCode() string }); ok {
+			return owner.GetExternalCode()
+		}
+		return ""
+	}}
+
+	// KeySyncID сопоставление по ID синхронизации (syncId).
+	KeySyncID = KeyFunc{Field: "syncId", Value: func(entity any) string {
+		if owner, ok := entity.(interface{ GetSyncID() string }); ok {
+			return owner.GetSyncID()
+		}
+		return ""
+	}}
+
+	// KeyCode сопоставление по коду (code).
+	KeyCode = KeyFunc{Field: "code", Value: func(entity any) string {
+		if owner, ok := entity.(interface{ GetCode() string }); ok {
+			return owner.GetCode()
+		}
+		return ""
+	}}
+
+	// KeyArticle сопоставление по артикулу (article).
+	KeyArticle = KeyFunc{Field: "article", Value: func(entity any) string {
+		if owner, ok := entity.(interface{ GetArticle() string }); ok {
+			return owner.GetArticle()
+		}
+		return ""
+	}}
+)
+
+// UpsertService описывает методы сервиса, необходимые для [Upsert].
+type UpsertService[T any] interface {
+	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[T], *resty.Response, error)
+	CreateUpdateMany(ctx context.Context, entities Slice[T], params ...func(*Params)) (*BulkResult[T], *resty.Response, error)
+}
+
+// UpsertOptions параметры [Upsert].
+type UpsertOptions struct {
+	// Не передавать объекты, значения полей которых совпадают с существующими объектами.
+	SkipUnchanged bool
+
+	// Параметры запроса на создание и изменение объектов.
+	Params []func(*Params)
+}
+
+// UpsertResult результат [Upsert].
+type UpsertResult[T any] struct {
+	Result    *BulkResult[T] // Результат запроса на создание и изменение. Равен nil, если запрос не выполнялся
+	Created   int            // Количество созданных объектов
+	Updated   int            // Количество изменённых объектов
+	Unchanged int            // Количество объектов, которые не передавались, так как не изменились
+	Failed    int            // Количество объектов, при обработке которых произошла ошибка
+}
+
+// String реализует интерфейс [fmt.Stringer].
+func (upsertResult UpsertResult[T]) String() string {
+	return fmt.Sprintf("created: %d, updated: %d, unchanged: %d, failed: %d",
+		upsertResult.Created, upsertResult.Updated, upsertResult.Unchanged, upsertResult.Failed)
+}
+
+// Upsert создаёт или изменяет объекты entities, сопоставляя их с существующими объектами по ключу key.
+//
+// Существующие объекты запрашиваются фильтром по значениям ключа, запросы разбиваются на части так,
+// чтобы длина параметра filter не превышала [MaxFilterLength]. Метаданные найденных объектов
+// устанавливаются в переданные объекты, после чего все объекты передаются одним вызовом CreateUpdateMany.
+//
+// Объекты entities изменяются: найденным объектам, в том числе неизменённым, устанавливаются метаданные.
+// Каждый объект должен содержать уникальное непустое значение ключа.
+//
+// Пример:
+//
+//	result, err := moysklad.Upsert(ctx, client.Entity().Product(), moysklad.KeyExternalCode, products, moysklad.UpsertOptions{SkipUnchanged: true})
+func Upsert[T any, PT interface {
+	*T
+	MetaOwner
+	SetMeta(meta *Meta) *T
+}](ctx context.Context, service UpsertService[T], key KeyFunc, entities Slice[T], opts UpsertOptions) (*UpsertResult[T], error) {
+	var (
+		indexes = make(map[string]int, entities.Len())
+		values  = make([]string, 0, entities.Len())
+	)
+
+	for i, entity := range entities {
+		if entity == nil {
+			return nil, fmt.Errorf("upsert: entity %d is nil", i)
+		}
+
+		value := key.Value(entity)
+		if value == "" {
+			return nil, fmt.Errorf("upsert: entity %d has empty %s", i, key.Field)
+		}
+		if j, ok := indexes[value]; ok {
+			return nil, fmt.Errorf("upsert: entities %d and %d have the same %s %q", j, i, key.Field, value)
+		}
+
+		indexes[value] = i
+		values = append(values, value)
+	}
+
+	existing := make(map[string]*T, len(values))
+
+	for _, chunk := range chunkFilterValues(key.Field, values) {
+		params := make([]func(*Params), 0, len(chunk))
+		for _, value := range chunk {
+			params = append(params, WithFilterEquals(key.Field, value))
+		}
+
+		found, _, err := service.GetListAll(ctx, params...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entity := range *found {
+			value := key.Value(entity)
+			if _, ok := indexes[value]; !ok {
+				// фильтр по строковым полям может вернуть объекты с ключом, отличающимся регистром
+				continue
+			}
+			if _, ok := existing[value]; ok {
+				return nil, fmt.Errorf("upsert: more than one entity with %s %q", key.Field, value)
+			}
+			existing[value] = entity
+		}
+	}
+
+	var (
+		result  = new(UpsertResult[T])
+		changes = make(Slice[T], 0, entities.Len())
+		updates = make([]bool, 0, entities.Len())
+	)
+
+	for _, entity := range entities {
+		found, ok := existing[key.Value(entity)]
+		if !ok {
+			changes = append(changes, entity)
+			updates = append(updates, false)
+			continue
+		}
+
+		// метаданные устанавливаются и неизменённым объектам, чтобы все переданные объекты ссылались на существующие
+		meta := PT(found).GetMeta()
+		PT(entity).SetMeta(&meta)
+
+		if opts.SkipUnchanged && isUnchanged(entity, found) {
+			result.Unchanged++
+			continue
+		}
+
+		changes = append(changes, entity)
+		updates = append(updates, true)
+	}
+
+	if changes.Len() == 0 {
+		return result, nil
+	}
+
+	bulkResult, _, err := service.CreateUpdateMany(ctx, changes, opts.Params...)
+	if bulkResult == nil {
+		return result, err
+	}
+
+	result.Result = bulkResult
+	for _, item := range bulkResult.Items {
+		switch {
+		case item.Error != nil:
+			result.Failed++
+		case updates[item.Index]:
+			result.Updated++
+		default:
+			result.Created++
+		}
+	}
+
+	return result, err
+}
+
+// chunkFilterValues разбивает значения ключа field на части так,
+// чтобы длина условий фильтрации каждой части не превышала [MaxFilterLength].
+func chunkFilterValues(field string, values []string) [][]string {
+	var (
+		chunks [][]string
+		chunk  []string
+		length int
+	)
+
+	for _, value := range values {
+		// условия разделяются символом ";", который после кодирования занимает 3 символа
+		size := len(url.QueryEscape(newFilter(field, value, FilterEquals))) + 3
+
+		if len(chunk) > 0 && (length+size > MaxFilterLength || len(chunk) == MaxPositions) {
+			chunks = append(chunks, chunk)
+			chunk, length = nil, 0
+		}
+
+		chunk = append(chunk, value)
+		length += size
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// isUnchanged возвращает true, если все заполненные поля объекта entity совпадают с полями существующего объекта.
+func isUnchanged(entity, existing any) bool {
+	var desired, actual any
+	if !unmarshalAsAny(entity, &desired) || !unmarshalAsAny(existing, &actual) {
+		return false
+	}
+
+	if fields, ok := desired.(map[string]any); ok {
+		// метаданные и служебные поля не сравниваются
+		for _, field := range []string{"meta", "id", "accountId", "updated"} {
+			delete(fields, field)
+		}
+	}
+
+	return containsJSON(actual, desired)
+}
+
+// unmarshalAsAny преобразует значение в представление JSON без потери точности чисел.
+func unmarshalAsAny(value any, target *any) bool {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(target) == nil
+}
+
+// containsJSON возвращает true, если значение actual содержит все поля значения desired с теми же значениями.
+//
+// Объекты сравниваются по полям desired, массивы – поэлементно.
+func containsJSON(actual, desired any) bool {
+	switch desired := desired.(type) {
+	case map[string]any:
+		actual, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+		if href, ok := desired["href"]; ok {
+			// метаданные сравниваются по ссылке на объект
+			return actual["href"] == href
+		}
+		for field, value := range desired {
+			if !containsJSON(actual[field], value) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		actual, ok := actual.([]any)
+		if !ok || len(actual) != len(desired) {
+			return false
+		}
+		for i := range desired {
+			if !containsJSON(actual[i], desired[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		actual, ok := actual.(json.Number)
+		if !ok {
+			return false
+		}
+		if actual == desired {
+			return true
+		}
+		l, errL := actual.Float64()
+		r, errR := desired.Float64()
+		return errL == nil && errR == nil && l == r
+	default:
+		return actual == desired
+	}
+}
@@ -0,0 +1,193 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/go-resty/resty/v2"
+)
+
+// upsertService сервис товаров для [moysklad.Upsert], который хранит товары в памяти.
+//
+// Как и сервер, сравнивает значения внешнего кода в фильтре без учёта регистра.
+type upsertService struct {
+	existing moysklad.Slice[moysklad.Product]
+	failed   map[string]bool // Внешние коды товаров, при изменении которых возвращается ошибка
+	filters  [][]string      // Условия фильтрации каждого запроса GetListAll
+	changes  moysklad.Slice[moysklad.Product]
+}
+
+func (service *upsertService) GetListAll(_ context.Context, params ...func(*moysklad.Params)) (*moysklad.Slice[moysklad.Product], *resty.Response, error) {
+	p := new(moysklad.Params)
+	for _, param := range params {
+		param(p)
+	}
+	service.filters = append(service.filters, p.Filter)
+
+	var found moysklad.Slice[moysklad.Product]
+	for _, product := range service.existing {
+		for _, condition := range p.Filter {
+			if strings.EqualFold(condition, "externalCode="+product.GetExternalCode()) {
+				found.Push(product)
+				break
+			}
+		}
+	}
+	return &found, nil, nil
+}
+
+func (service *upsertService) CreateUpdateMany(_ context.Context, products moysklad.Slice[moysklad.Product], _ ...func(*moysklad.Params)) (*moysklad.BulkResult[moysklad.Product], *resty.Response, error) {
+	service.changes = products
+
+	result := new(moysklad.BulkResult[moysklad.Product])
+	for i, product := range products {
+		item := moysklad.BulkItem[moysklad.Product]{Index: i}
+		if service.failed[product.GetExternalCode()] {
+			item.Error = errors.New("failed")
+		} else {
+			item.Entity = product
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil, nil
+}
+
+// existingProduct возвращает товар с метаданными, внешним кодом code и названием name.
+func existingProduct(code, name string) *moysklad.Product {
+	product := &moysklad.Product{ExternalCode: moysklad.String(code), Name: moysklad.String(name)}
+	return product.SetMeta(new(moysklad.Meta).SetHref("https://api.moysklad.ru/api/remap/1.2/entity/product/" + code))
+}
+
+func TestUpsertChunksFilter(t *testing.T) {
+	service := new(upsertService)
+
+	const n = 300
+	products := make(moysklad.Slice[moysklad.Product], 0, n)
+	for i := range n {
+		products.Push(&moysklad.Product{ExternalCode: moysklad.String(fmt.Sprintf("external-code;%04d", i))})
+	}
+
+	result, err := moysklad.Upsert(context.Background(), service, moysklad.KeyExternalCode, products, moysklad.UpsertOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Created != n {
+		t.Fatalf("got result %s, want %d created", result, n)
+	}
+
+	if len(service.filters) < 2 {
+		t.Fatalf("got %d filter requests, want filter split into chunks", len(service.filters))
+	}
+
+	var conditions int
+	for i, filter := range service.filters {
+		if length := len(url.QueryEscape(strings.Join(filter, ";"))); length > moysklad.MaxFilterLength {
+			t.Fatalf("request %d: got filter length %d, want at most %d", i, length, moysklad.MaxFilterLength)
+		}
+		conditions += len(filter)
+	}
+	if conditions != n {
+		t.Fatalf("got %d filter conditions, want %d", conditions, n)
+	}
+}
+
+func TestUpsertDuplicateKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing moysklad.Slice[moysklad.Product]
+		products moysklad.Slice[moysklad.Product]
+		requests int
+	}{
+		{
+			name:     "entities",
+			products: moysklad.Slice[moysklad.Product]{existingProduct("a", ""), existingProduct("a", "")},
+		},
+		{
+			name:     "empty key",
+			products: moysklad.Slice[moysklad.Product]{{Name: moysklad.String("без кода")}},
+		},
+		{
+			name:     "existing",
+			existing: moysklad.Slice[moysklad.Product]{existingProduct("a", "1"), existingProduct("a", "2")},
+			products: moysklad.Slice[moysklad.Product]{{ExternalCode: moysklad.String("a")}},
+			requests: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := &upsertService{existing: test.existing}
+
+			if _, err := moysklad.Upsert(context.Background(), service, moysklad.KeyExternalCode, test.products, moysklad.UpsertOptions{}); err == nil {
+				t.Fatal("got no error, want error")
+			}
+			if len(service.filters) != test.requests || service.changes != nil {
+				t.Fatalf("got %d filter requests and %d changes, want %d and none", len(service.filters), service.changes.Len(), test.requests)
+			}
+		})
+	}
+}
+
+func TestUpsertCaseMismatch(t *testing.T) {
+	service := &upsertService{existing: moysklad.Slice[moysklad.Product]{existingProduct("ABC", "товар")}}
+	products := moysklad.Slice[moysklad.Product]{{ExternalCode: moysklad.String("abc"), Name: moysklad.String("товар")}}
+
+	result, err := moysklad.Upsert(context.Background(), service, moysklad.KeyExternalCode, products, moysklad.UpsertOptions{SkipUnchanged: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// объект с ключом, отличающимся регистром, не считается существующим
+	if result.Created != 1 || result.Updated+result.Unchanged != 0 {
+		t.Fatalf("got result %s, want 1 created", result)
+	}
+	if products[0].Meta != nil {
+		t.Fatalf("got meta %s, want none", products[0].Meta)
+	}
+}
+
+func TestUpsertResult(t *testing.T) {
+	service := &upsertService{
+		existing: moysklad.Slice[moysklad.Product]{existingProduct("a", "A"), existingProduct("b", "B")},
+		failed:   map[string]bool{"d": true},
+	}
+
+	products := moysklad.Slice[moysklad.Product]{
+		{ExternalCode: moysklad.String("a"), Name: moysklad.String("A")},     // не изменился
+		{ExternalCode: moysklad.String("c"), Name: moysklad.String("C")},     // создаётся
+		{ExternalCode: moysklad.String("b"), Name: moysklad.String("B new")}, // изменяется
+		{ExternalCode: moysklad.String("d"), Name: moysklad.String("D")},     // ошибка создания
+	}
+
+	result, err := moysklad.Upsert(context.Background(), service, moysklad.KeyExternalCode, products, moysklad.UpsertOptions{SkipUnchanged: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Created != 1 || result.Updated != 1 || result.Unchanged != 1 || result.Failed != 1 {
+		t.Fatalf("got result %s, want one of each", result)
+	}
+
+	var codes []string
+	for _, product := range service.changes {
+		codes = append(codes, product.GetExternalCode())
+	}
+	if strings.Join(codes, ",") != "c,b,d" {
+		t.Fatalf("got changes %v, want c,b,d", codes)
+	}
+
+	// метаданные устанавливаются и неизменённому объекту
+	for _, i := range []int{0, 2} {
+		if want := service.existing[i/2].GetMeta().GetHref(); products[i].GetMeta().GetHref() != want {
+			t.Fatalf("entity %d: got meta %q, want %q", i, products[i].GetMeta().GetHref(), want)
+		}
+	}
+	if products[1].Meta != nil || products[3].Meta != nil {
+		t.Fatal("got meta for new entities, want none")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -446,6 +447,21 @@ type VariantService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Variant], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех модификаций.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Variant, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех модификаций.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Variant]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех модификаций асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Variant, error]
+
 	// Create выполняет запрос на создание заказа модификации.
 	// Обязательные поля для заполнения:
 	//	- product (Метаданные товара, к которому привязана Модификация)
@@ -457,13 +473,13 @@ type VariantService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение модификаций.
 	// Изменяемые модификации должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список модификаций и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых модификаций.
-	CreateUpdateMany(ctx context.Context, variantList Slice[Variant], params ...func(*Params)) (*Slice[Variant], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых модификаций и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, variantList Slice[Variant], params ...func(*Params)) (*BulkResult[Variant], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление модификаций.
 	// Принимает контекст и множество модификаций.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Variant) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Variant) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление модификации по ID.
 	// Принимает контекст и ID модификации.
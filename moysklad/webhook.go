@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Webhook Вебхук.
@@ -179,8 +180,9 @@ func (webhook *Webhook) Delete(ctx context.Context, client *Client) (bool, *rest
 
 // MarshalJSON реализует интерфейс [json.Marshaler].
 func (webhook Webhook) MarshalJSON() ([]byte, error) {
+	type alias Webhook
 	webhook.Method = String("POST")
-	return json.Marshal(webhook)
+	return json.Marshal(alias(webhook))
 }
 
 // WebhookAction Действие, которое отслеживается веб-хуком.
@@ -229,6 +231,21 @@ type WebhookService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Webhook], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех вебхуков.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Webhook, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех вебхуков.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[Webhook]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех вебхуков асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*Webhook, error]
+
 	// Create выполняет запрос на создание вебхука.
 	// Обязательные поля для заполнения:
 	//	- entityType (Тип сущности, к которой привязан вебхук)
@@ -241,13 +258,13 @@ type WebhookService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение вебхуков.
 	// Изменяемые вебхуки должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список вебхуков и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых вебхуков.
-	CreateUpdateMany(ctx context.Context, webhookList Slice[Webhook], params ...func(*Params)) (*Slice[Webhook], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых вебхуков и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, webhookList Slice[Webhook], params ...func(*Params)) (*BulkResult[Webhook], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление вебхуков.
 	// Принимает контекст и множество вебхуков.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*Webhook) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*Webhook) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление вебхука по ID.
 	// Принимает контекст и ID вебхука.
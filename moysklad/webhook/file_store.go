@@ -0,0 +1,287 @@
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// fileStoreCompactThreshold минимальное количество записей, дописанных в журнал после его перезаписи,
+// после которого журнал перезаписывается снова.
+const fileStoreCompactThreshold = 10000
+
+// Операции журнала [FileStore].
+const (
+	fileOpPut   = "put"
+	fileOpAck   = "ack"
+	fileOpRetry = "retry"
+	fileOpDone  = "done" // ключи подтверждённых событий, записанные при перезаписи журнала
+)
+
+// fileEntry запись журнала [FileStore].
+type fileEntry struct {
+	Op     string     `json:"op"`
+	Record *Record    `json:"record,omitempty"`
+	IDs    []string   `json:"ids,omitempty"`
+	Done   []fileDone `json:"done,omitempty"`
+	Time   time.Time  `json:"time"`
+}
+
+// fileDone ключ подтверждённого события и момент подтверждения.
+type fileDone struct {
+	ID   string    `json:"id"`
+	Time time.Time `json:"time"`
+}
+
+// FileStore хранилище событий в файле.
+//
+// Каждая операция дописывается в журнал и синхронизируется с диском до возврата из метода.
+// При открытии журнала события, взятые в работу, но не подтверждённые, снова становятся доступными для обработки.
+type FileStore struct {
+	mem       *MemoryStore
+	file      *os.File
+	path      string
+	entries   int // Количество записей журнала (ключи подтверждённых событий при перезаписи учитываются по одному)
+	compacted int // Количество записей журнала после последней перезаписи
+	mu        sync.Mutex
+}
+
+// OpenFileStore открывает или создаёт хранилище событий в файле path.
+func OpenFileStore(path string) (*FileStore, error) {
+	store := &FileStore{mem: NewMemoryStore(), path: path}
+
+	if err := store.load(); err != nil {
+		return nil, err
+	}
+
+	if err := store.compact(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Close закрывает файл журнала.
+func (store *FileStore) Close() error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.file.Close()
+}
+
+// Len возвращает количество необработанных событий.
+func (store *FileStore) Len() int {
+	return store.mem.Len()
+}
+
+// Put сохраняет событие.
+func (store *FileStore) Put(_ context.Context, record *Record) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.mem.mu.Lock()
+	_, pending := store.mem.records[record.ID]
+	_, done := store.mem.done[record.ID]
+	store.mem.mu.Unlock()
+
+	if pending || done {
+		return false, nil
+	}
+
+	if err := store.write(fileEntry{Op: fileOpPut, Record: record, Time: time.Now()}); err != nil {
+		return false, err
+	}
+
+	return store.mem.Put(context.Background(), record)
+}
+
+// Claim возвращает не более limit событий, готовых к обработке, в порядке очереди.
+func (store *FileStore) Claim(ctx context.Context, limit int, now time.Time) ([]*Record, error) {
+	return store.mem.Claim(ctx, limit, now)
+}
+
+// Ack подтверждает обработку событий.
+func (store *FileStore) Ack(ctx context.Context, ids ...string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if err := store.write(fileEntry{Op: fileOpAck, IDs: ids, Time: time.Now()}); err != nil {
+		return err
+	}
+
+	_ = store.mem.Ack(ctx, ids...)
+
+	// журнал перезаписывается, когда дописанные записи превышают его размер после последней перезаписи,
+	// поэтому ключи подтверждённых событий, хранящиеся в течение DedupWindow, не приводят к перезаписи при каждом Ack
+	if appended := store.entries - store.compacted; appended > max(store.compacted, fileStoreCompactThreshold) {
+		return store.compact()
+	}
+	return nil
+}
+
+// Retry возвращает событие в очередь.
+func (store *FileStore) Retry(ctx context.Context, id string, next time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if err := store.write(fileEntry{Op: fileOpRetry, IDs: []string{id}, Time: next}); err != nil {
+		return err
+	}
+
+	return store.mem.Retry(ctx, id, next)
+}
+
+// Release возвращает взятые в работу события в очередь.
+//
+// Взятие в работу не сохраняется в журнале, поэтому операция не записывается.
+func (store *FileStore) Release(ctx context.Context, ids ...string) error {
+	return store.mem.Release(ctx, ids...)
+}
+
+// load восстанавливает состояние хранилища из журнала.
+func (store *FileStore) load() error {
+	file, err := os.Open(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// запись ключей подтверждённых событий может быть длиннее буфера bufio.Scanner, поэтому строки читаются целиком
+	reader := bufio.NewReader(file)
+
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		last := err != nil
+		if last && len(bytes.TrimSpace(data)) == 0 {
+			return nil
+		}
+
+		var entry fileEntry
+		if err = json.Unmarshal(data, &entry); err != nil {
+			// запись, прерванная при аварийном завершении, может быть только последней
+			if last {
+				return nil
+			}
+			return fmt.Errorf("webhook: %s:%d: %w", store.path, line, err)
+		}
+
+		switch entry.Op {
+		case fileOpPut:
+			if entry.Record != nil {
+				store.mem.put(entry.Record)
+			}
+		case fileOpAck:
+			store.mem.ack(entry.IDs, entry.Time)
+		case fileOpDone:
+			for _, done := range entry.Done {
+				store.mem.ack([]string{done.ID}, done.Time)
+			}
+		case fileOpRetry:
+			for _, id := range entry.IDs {
+				store.mem.retry(id, entry.Time)
+			}
+		}
+
+		if last {
+			return nil
+		}
+	}
+}
+
+// compact перезаписывает журнал текущим состоянием хранилища.
+//
+// Новый журнал записывается во временный файл, который заменяет журнал после синхронизации с диском.
+// При ошибке продолжает использоваться прежний журнал.
+func (store *FileStore) compact() error {
+	tmp, err := os.CreateTemp(filepath.Dir(store.path), ".webhook-store-*")
+	if err != nil {
+		return err
+	}
+
+	var (
+		entries []fileEntry
+		size    int // количество ключей и событий в новом журнале
+	)
+
+	store.mem.mu.Lock()
+	store.mem.prune(time.Now())
+	// ключи подтверждённых событий записываются одной записью в порядке подтверждения
+	if keys := store.mem.doneKeys(); len(keys) > 0 {
+		done := make([]fileDone, 0, len(keys))
+		for _, key := range keys {
+			done = append(done, fileDone{ID: key.id, Time: key.at})
+		}
+		entries = append(entries, fileEntry{Op: fileOpDone, Done: done, Time: time.Now()})
+		size += len(done)
+	}
+	for _, record := range store.mem.records {
+		r := record.Record
+		entries = append(entries, fileEntry{Op: fileOpPut, Record: &r, Time: r.ReceivedAt})
+		size++
+	}
+	store.mem.mu.Unlock()
+
+	if err = writeEntries(tmp, entries); err == nil {
+		err = os.Rename(tmp.Name(), store.path)
+	}
+	if err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	// временный файл стал журналом, дальнейшие записи дописываются в него
+	if store.file != nil {
+		_ = store.file.Close()
+	}
+
+	store.file = tmp
+	store.entries = size
+	store.compacted = size
+	return nil
+}
+
+// writeEntries записывает записи журнала в файл и синхронизирует его с диском.
+func writeEntries(file *os.File, entries []fileEntry) error {
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// write дописывает запись в журнал.
+func (store *FileStore) write(entry fileEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if _, err = store.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	store.entries++
+	return store.file.Sync()
+}
@@ -0,0 +1,162 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arcsub/go-moysklad/moysklad/webhook"
+)
+
+func TestFileStoreReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhook.log")
+	ctx := context.Background()
+
+	store, err := webhook.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		ids     []string
+		records []*webhook.Record
+	)
+	for i := range 3 {
+		record := updateRecord(fmt.Sprint(i), 0, "name")
+		if _, err = store.Put(ctx, record); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, record.ID)
+		records = append(records, record)
+	}
+
+	if err = store.Ack(ctx, ids[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = store.Claim(ctx, 0, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// журнал перезаписывается при открытии, записи после перезаписи должны сохраниться
+	for range 2 {
+		store, err = webhook.OpenFileStore(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if store.Len() != 2 {
+			t.Fatalf("got %d events, want 2", store.Len())
+		}
+
+		added, err := store.Put(ctx, records[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if added {
+			t.Fatal("acknowledged event stored again")
+		}
+
+		// события, взятые в работу до закрытия, снова доступны
+		claimed, err := store.Claim(ctx, 0, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(claimed) != 2 {
+			t.Fatalf("got %d claimed events, want 2", len(claimed))
+		}
+
+		if err = store.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store, err = webhook.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Ack(ctx, ids[1:]...); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = webhook.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	if store.Len() != 0 {
+		t.Fatalf("got %d events after ack, want 0", store.Len())
+	}
+}
+
+func TestFileStoreDedupWindow(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhook.log")
+	ctx := context.Background()
+
+	var (
+		now     = time.Now()
+		expired = updateRecord("1", 0, "name")
+		recent  = updateRecord("2", 0, "name")
+		journal bytes.Buffer
+	)
+
+	// ключ expired подтверждён раньше окна исключения повторов, ключ recent – внутри окна
+	for _, entry := range []map[string]any{
+		{"op": "ack", "ids": []string{expired.ID}, "time": now.Add(-webhook.DedupWindow - time.Hour)},
+		{"op": "ack", "ids": []string{recent.ID}, "time": now.Add(-time.Hour)},
+	} {
+		if err := json.NewEncoder(&journal).Encode(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(path, journal.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := webhook.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ключи подтверждённых событий записываются при перезаписи журнала одной записью
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 1 || !strings.Contains(string(data), `"op":"done"`) {
+		t.Fatalf("got compacted journal %s, want one done entry", data)
+	}
+	if strings.Contains(string(data), expired.ID) {
+		t.Fatal("got expired key in compacted journal")
+	}
+
+	for range 2 {
+		if added, err := store.Put(ctx, recent); err != nil || added {
+			t.Fatalf("got added %v, %v for recent key, want not added", added, err)
+		}
+		if err = store.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if store, err = webhook.OpenFileStore(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer store.Close()
+
+	if added, err := store.Put(ctx, expired); err != nil || !added {
+		t.Fatalf("got added %v, %v for expired key, want added", added, err)
+	}
+}
@@ -0,0 +1,387 @@
+// Package webhook содержит обработчик уведомлений вебхуков МойСклад.
+//
+// Обработчик [Handler] реализует интерфейс [http.Handler]: принимает POST-запрос с объектом
+// [moysklad.WebhookNotification], сразу отвечает статусом 200 и передаёт каждое событие
+// зарегистрированным обработчикам по коду сущности и действию.
+//
+// # Пример:
+//
+//	handler := webhook.New(webhook.WithFetch(client, "agent", "positions"))
+//
+//	webhook.OnUpdate(handler, func(ctx context.Context, order *moysklad.CustomerOrder, event moysklad.Event) error {
+//		fmt.Println(order.GetName(), event.UpdatedFields)
+//		return nil
+//	})
+//
+//	http.Handle("/moysklad/webhook", handler)
+//
+// # Хранилище событий
+//
+// С параметром [WithStore] события сохраняются в хранилище [EventStore] до ответа на запрос,
+// а обрабатываются пулом обработчиков, запущенным методом [Handler.Run].
+// Событие удаляется из хранилища только после успешной обработки, при ошибке обработка повторяется.
+//
+//	store, err := webhook.OpenFileStore("webhook.log")
+//	handler := webhook.New(webhook.WithStore(store), webhook.WithWorkers(8))
+//
+//	go handler.Run(ctx)
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/arcsub/go-moysklad/moysklad"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMaxBodySize    = 10 << 20         // Максимальный размер тела запроса по умолчанию
+	DefaultHandlerTimeout = 60 * time.Second // Время на обработку одного уведомления по умолчанию
+	DefaultWorkers        = 4                // Количество обработчиков событий из хранилища по умолчанию
+	DefaultMaxAttempts    = 5                // Количество попыток обработки события из хранилища по умолчанию
+	DefaultMinRetryDelay  = time.Second      // Минимальная задержка перед повторной обработкой события по умолчанию
+	DefaultMaxRetryDelay  = 5 * time.Minute  // Максимальная задержка перед повторной обработкой события по умолчанию
+	DefaultPollInterval   = time.Second      // Интервал проверки хранилища на наличие событий по умолчанию
+)
+
+// EventHandlerFunc функция обработки события вебхука.
+type EventHandlerFunc func(ctx context.Context, event moysklad.Event) error
+
+// route ключ маршрутизации события.
+type route struct {
+	metaType moysklad.MetaType
+	action   moysklad.WebhookAction
+}
+
+// Handler обработчик уведомлений вебхуков.
+//
+// Создаётся с помощью функции [New].
+type Handler struct {
+	client        *moysklad.Client
+	location      *time.Location
+	store         EventStore
+	routes        map[route][]EventHandlerFunc
+	errorFunc     func(error)
+	baseContext   func() context.Context
+	notify        chan struct{}
+	expand        []string
+	maxBodySize   int64
+	timeout       time.Duration
+	coalesce      time.Duration
+	minRetryDelay time.Duration
+	maxRetryDelay time.Duration
+	pollInterval  time.Duration
+	workers       int
+	maxAttempts   int
+	wg            sync.WaitGroup
+	mu            sync.RWMutex
+	fetch         bool
+}
+
+// Option параметр обработчика [Handler].
+type Option func(*Handler)
+
+// WithFetch включает получение полной сущности через [moysklad.FetchMeta] перед вызовом обработчика события.
+//
+// Для действия DELETE сущность не запрашивается.
+//
+// expand – список полей для замены ссылок объектами.
+//
+// Если часовой пояс не задан с помощью [WithLocation], используется часовой пояс учётной записи клиента.
+func WithFetch(client *moysklad.Client, expand ...string) Option {
+	return func(handler *Handler) {
+		handler.client = client
+		if handler.location == nil {
+			handler.location = client.Location()
+		}
+		handler.expand = expand
+		handler.fetch = true
+	}
+}
+
+// WithLocation устанавливает часовой пояс учётной записи, в котором разбираются даты уведомлений.
+//
+// Если не указан, используется [moysklad.Location].
+func WithLocation(loc *time.Location) Option {
+	return func(handler *Handler) {
+		handler.location = loc
+	}
+}
+
+// WithErrorFunc устанавливает функцию, которая вызывается при ошибке или панике в обработчике события,
+// а также при ошибке разбора тела запроса или сохранения события в хранилище.
+//
+// Ошибки обработчиков событий не передаются в МойСклад: обработчик отвечает статусом 200.
+// Если событие не удалось сохранить в хранилище [EventStore], обработчик отвечает статусом 503,
+// чтобы МойСклад повторил доставку уведомления.
+func WithErrorFunc(fn func(error)) Option {
+	return func(handler *Handler) {
+		handler.errorFunc = fn
+	}
+}
+
+// WithBaseContext устанавливает функцию, возвращающую базовый контекст для обработки событий.
+//
+// По умолчанию используется [context.Background].
+func WithBaseContext(fn func() context.Context) Option {
+	return func(handler *Handler) {
+		handler.baseContext = fn
+	}
+}
+
+// WithTimeout устанавливает время на обработку одного уведомления.
+func WithTimeout(timeout time.Duration) Option {
+	return func(handler *Handler) {
+		handler.timeout = timeout
+	}
+}
+
+// WithMaxBodySize устанавливает максимальный размер тела запроса.
+func WithMaxBodySize(size int64) Option {
+	return func(handler *Handler) {
+		handler.maxBodySize = size
+	}
+}
+
+// WithStore устанавливает хранилище, в котором события сохраняются до успешной обработки.
+//
+// Повторно доставленные события с тем же ключом (см. [RecordID]) не обрабатываются.
+// Обработка событий из хранилища выполняется методом [Handler.Run].
+func WithStore(store EventStore) Option {
+	return func(handler *Handler) {
+		handler.store = store
+	}
+}
+
+// WithWorkers устанавливает количество одновременно обрабатываемых событий из хранилища.
+func WithWorkers(workers int) Option {
+	return func(handler *Handler) {
+		handler.workers = max(workers, 1)
+	}
+}
+
+// WithMaxAttempts устанавливает количество попыток обработки события из хранилища.
+//
+// После последней неудачной попытки событие удаляется из хранилища, а ошибка передаётся в функцию [WithErrorFunc].
+func WithMaxAttempts(attempts int) Option {
+	return func(handler *Handler) {
+		handler.maxAttempts = max(attempts, 1)
+	}
+}
+
+// WithRetryDelay устанавливает минимальную и максимальную задержку перед повторной обработкой события.
+//
+// Задержка удваивается с каждой неудачной попыткой.
+func WithRetryDelay(minDelay, maxDelay time.Duration) Option {
+	return func(handler *Handler) {
+		handler.minRetryDelay = minDelay
+		handler.maxRetryDelay = max(minDelay, maxDelay)
+	}
+}
+
+// WithCoalesce устанавливает задержку обработки событий из хранилища.
+//
+// События UPDATE одной сущности, накопившиеся за время задержки, обрабатываются одним вызовом
+// с объединённым списком изменённых полей. При использовании [WithFetch] сущность запрашивается один раз.
+func WithCoalesce(delay time.Duration) Option {
+	return func(handler *Handler) {
+		handler.coalesce = delay
+	}
+}
+
+// WithPollInterval устанавливает интервал проверки хранилища на наличие событий, готовых к повторной обработке.
+func WithPollInterval(interval time.Duration) Option {
+	return func(handler *Handler) {
+		handler.pollInterval = interval
+	}
+}
+
+// New возвращает новый обработчик уведомлений вебхуков.
+func New(options ...Option) *Handler {
+	handler := &Handler{
+		routes:        make(map[route][]EventHandlerFunc),
+		errorFunc:     func(error) {},
+		baseContext:   context.Background,
+		notify:        make(chan struct{}, 1),
+		maxBodySize:   DefaultMaxBodySize,
+		timeout:       DefaultHandlerTimeout,
+		minRetryDelay: DefaultMinRetryDelay,
+		maxRetryDelay: DefaultMaxRetryDelay,
+		pollInterval:  DefaultPollInterval,
+		workers:       DefaultWorkers,
+		maxAttempts:   DefaultMaxAttempts,
+	}
+
+	for _, option := range options {
+		option(handler)
+	}
+
+	return handler
+}
+
+// Handle регистрирует обработчик событий для указанного кода сущности и действия.
+func (handler *Handler) Handle(metaType moysklad.MetaType, action moysklad.WebhookAction, fn EventHandlerFunc) {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	key := route{metaType, action}
+	handler.routes[key] = append(handler.routes[key], fn)
+}
+
+// On регистрирует типизированный обработчик событий сущности T с действием action.
+//
+// Код сущности определяется по типу T. Если обработчик создан с параметром [WithFetch],
+// в функцию передаётся полная сущность, иначе – сущность, содержащая только метаданные.
+func On[T moysklad.MetaTyper](handler *Handler, action moysklad.WebhookAction, fn func(ctx context.Context, entity *T, event moysklad.Event) error) {
+	var zero T
+
+	handler.Handle(zero.MetaType(), action, func(ctx context.Context, event moysklad.Event) error {
+		entity, err := resolve[T](ctx, handler, event)
+		if err != nil {
+			return err
+		}
+		return fn(ctx, entity, event)
+	})
+}
+
+// OnCreate регистрирует обработчик событий создания сущности T.
+func OnCreate[T moysklad.MetaTyper](handler *Handler, fn func(ctx context.Context, entity *T, event moysklad.Event) error) {
+	On(handler, moysklad.WebhookActionCreate, fn)
+}
+
+// OnUpdate регистрирует обработчик событий изменения сущности T.
+func OnUpdate[T moysklad.MetaTyper](handler *Handler, fn func(ctx context.Context, entity *T, event moysklad.Event) error) {
+	On(handler, moysklad.WebhookActionUpdate, fn)
+}
+
+// OnDelete регистрирует обработчик событий удаления сущности T.
+func OnDelete[T moysklad.MetaTyper](handler *Handler, fn func(ctx context.Context, entity *T, event moysklad.Event) error) {
+	On(handler, moysklad.WebhookActionDelete, fn)
+}
+
+// resolve возвращает сущность T для события.
+func resolve[T any](ctx context.Context, handler *Handler, event moysklad.Event) (*T, error) {
+	if handler.fetch && event.Action != moysklad.WebhookActionDelete {
+		entity, _, err := moysklad.FetchMeta[T](ctx, handler.client, event.Meta, moysklad.WithExpand(handler.expand...))
+		if err != nil {
+			return nil, fmt.Errorf("webhook: fetch %s: %w", event.Meta.GetHref(), err)
+		}
+		return entity, nil
+	}
+
+	// сущность, содержащая только метаданные
+	data, err := json.Marshal(map[string]moysklad.Meta{"meta": event.Meta})
+	if err != nil {
+		return nil, err
+	}
+
+	var entity T
+	if err = json.Unmarshal(data, &entity); err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+type auditContextKey struct{}
+
+// AuditContextFrom возвращает контекст аудита уведомления, в рамках которого вызван обработчик события.
+func AuditContextFrom(ctx context.Context) (moysklad.AuditContext, bool) {
+	auditContext, ok := ctx.Value(auditContextKey{}).(moysklad.AuditContext)
+	return auditContext, ok
+}
+
+// ServeHTTP реализует интерфейс [http.Handler].
+//
+// Разбирает тело запроса и отвечает статусом 200 до обработки событий.
+// События обрабатываются в отдельной горутине, а при использовании [WithStore] –
+// сохраняются в хранилище и обрабатываются методом [Handler.Run].
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var notification moysklad.WebhookNotification
+	if err := json.NewDecoder(io.LimitReader(r.Body, handler.maxBodySize)).Decode(&notification); err != nil {
+		w.WriteHeader(http.StatusOK)
+		handler.errorFunc(fmt.Errorf("webhook: decode notification: %w", err))
+		return
+	}
+	notification = moysklad.ConvertTimestamps(notification, moysklad.Location(), handler.location)
+
+	if handler.store != nil {
+		if err := handler.save(r.Context(), &notification); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			handler.errorFunc(err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	handler.wg.Add(1)
+	go func() {
+		defer handler.wg.Done()
+		handler.handleNotification(&notification)
+	}()
+}
+
+// Wait ожидает завершения обработки всех принятых уведомлений.
+func (handler *Handler) Wait() {
+	handler.wg.Wait()
+}
+
+// handleNotification передаёт каждое событие уведомления обработчикам.
+func (handler *Handler) handleNotification(notification *moysklad.WebhookNotification) {
+	ctx, cancel := context.WithTimeout(handler.baseContext(), handler.timeout)
+	defer cancel()
+
+	for _, event := range notification.Events {
+		if event == nil {
+			continue
+		}
+
+		if err := handler.Dispatch(ctx, notification.AuditContext, *event); err != nil {
+			handler.errorFunc(err)
+		}
+	}
+}
+
+// Dispatch вызывает обработчики, зарегистрированные для кода сущности и действия события.
+//
+// Паника в обработчике перехватывается и возвращается в виде ошибки.
+func (handler *Handler) Dispatch(ctx context.Context, auditContext moysklad.AuditContext, event moysklad.Event) error {
+	handler.mu.RLock()
+	fns := handler.routes[route{event.Meta.GetType(), event.Action}]
+	handler.mu.RUnlock()
+
+	ctx = context.WithValue(ctx, auditContextKey{}, auditContext)
+
+	var errs []error
+	for _, fn := range fns {
+		if err := safeCall(ctx, fn, event); err != nil {
+			errs = append(errs, fmt.Errorf("webhook: %s %s %s: %w", event.Action, event.Meta.GetType(), event.Meta.GetHref(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// safeCall вызывает обработчик события, перехватывая панику.
+func safeCall(ctx context.Context, fn EventHandlerFunc, event moysklad.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return fn(ctx, event)
+}
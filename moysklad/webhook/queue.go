@@ -0,0 +1,232 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arcsub/go-moysklad/moysklad"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ErrNoStore возвращается методом [Handler.Run], если обработчик создан без параметра [WithStore].
+var ErrNoStore = errors.New("webhook: event store is not configured")
+
+// job событие или группа объединённых событий UPDATE одной сущности.
+type job struct {
+	records []*Record
+}
+
+// event возвращает событие для обработки.
+//
+// Для группы событий возвращается последнее событие с объединённым списком изменённых полей.
+func (job job) event() (moysklad.AuditContext, moysklad.Event) {
+	last := job.records[len(job.records)-1]
+	event := last.Event
+
+	if len(job.records) > 1 {
+		var fields moysklad.Slice[string]
+		for _, record := range job.records {
+			for _, field := range record.Event.UpdatedFields {
+				if field != nil && !slices.ContainsFunc(fields, func(f *string) bool { return *f == *field }) {
+					fields = append(fields, field)
+				}
+			}
+		}
+		event.UpdatedFields = fields
+	}
+
+	return last.AuditContext, event
+}
+
+// ids возвращает ключи событий группы.
+func (job job) ids() []string {
+	ids := make([]string, 0, len(job.records))
+	for _, record := range job.records {
+		ids = append(ids, record.ID)
+	}
+	return ids
+}
+
+// save сохраняет события уведомления в хранилище.
+func (handler *Handler) save(ctx context.Context, notification *moysklad.WebhookNotification) error {
+	now := time.Now()
+
+	for _, event := range notification.Events {
+		if event == nil {
+			continue
+		}
+
+		record := &Record{
+			ID:           RecordID(notification.AuditContext, *event),
+			AuditContext: notification.AuditContext,
+			Event:        *event,
+			ReceivedAt:   now,
+			NextAttempt:  now.Add(handler.coalesce),
+		}
+
+		if _, err := handler.store.Put(ctx, record); err != nil {
+			return fmt.Errorf("webhook: store event %s: %w", record.ID, err)
+		}
+	}
+
+	select {
+	case handler.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Run обрабатывает события из хранилища [WithStore] до отмены контекста.
+//
+// Одновременно обрабатывается не более [WithWorkers] событий.
+// После успешной обработки событие подтверждается, при ошибке – возвращается в хранилище
+// для повторной обработки с увеличивающейся задержкой.
+// При отмене контекста метод дожидается завершения начатой обработки и возвращает ошибку контекста.
+func (handler *Handler) Run(ctx context.Context) error {
+	if handler.store == nil {
+		return ErrNoStore
+	}
+
+	var (
+		wg   sync.WaitGroup
+		jobs = make(chan job)
+	)
+
+	for range handler.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				handler.process(job)
+			}
+		}()
+	}
+
+	defer func() {
+		close(jobs)
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(handler.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		records, err := handler.store.Claim(ctx, handler.workers, time.Now())
+		if err != nil {
+			handler.errorFunc(fmt.Errorf("webhook: claim events: %w", err))
+		}
+
+		pending := group(records)
+		for i, job := range pending {
+			select {
+			case jobs <- job:
+			case <-ctx.Done():
+				handler.release(pending[i:])
+				return ctx.Err()
+			}
+		}
+
+		// хранилище может содержать ещё готовые события
+		if len(pending) == handler.workers {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-handler.notify:
+			if handler.coalesce > 0 {
+				timer := time.NewTimer(handler.coalesce)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				case <-timer.C:
+				}
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// group объединяет события UPDATE одной сущности, сохраняя порядок событий.
+func group(records []*Record) []job {
+	var (
+		jobs    []job
+		updates = make(map[string]int)
+	)
+
+	for _, record := range records {
+		if record.Event.Action == moysklad.WebhookActionUpdate {
+			href := record.Event.Meta.GetHref()
+			if i, ok := updates[href]; ok {
+				jobs[i].records = append(jobs[i].records, record)
+				continue
+			}
+			updates[href] = len(jobs)
+		}
+		jobs = append(jobs, job{records: []*Record{record}})
+	}
+
+	return jobs
+}
+
+// process обрабатывает событие и подтверждает его или возвращает в хранилище.
+func (handler *Handler) process(job job) {
+	ctx, cancel := context.WithTimeout(handler.baseContext(), handler.timeout)
+	defer cancel()
+
+	auditContext, event := job.event()
+
+	err := handler.Dispatch(ctx, auditContext, event)
+	if err == nil {
+		if err = handler.store.Ack(ctx, job.ids()...); err != nil {
+			handler.errorFunc(fmt.Errorf("webhook: ack events: %w", err))
+		}
+		return
+	}
+
+	handler.errorFunc(err)
+
+	for _, record := range job.records {
+		if record.Attempts+1 >= handler.maxAttempts {
+			handler.errorFunc(fmt.Errorf("webhook: event %s dropped after %d attempts", record.ID, record.Attempts+1))
+			if err = handler.store.Ack(ctx, record.ID); err != nil {
+				handler.errorFunc(fmt.Errorf("webhook: ack events: %w", err))
+			}
+			continue
+		}
+
+		if err = handler.store.Retry(ctx, record.ID, time.Now().Add(handler.retryDelay(record.Attempts))); err != nil {
+			handler.errorFunc(fmt.Errorf("webhook: retry event %s: %w", record.ID, err))
+		}
+	}
+}
+
+// release возвращает взятые в работу, но не переданные обработчикам события в хранилище
+// без учёта попытки обработки.
+func (handler *Handler) release(jobs []job) {
+	var ids []string
+	for _, job := range jobs {
+		ids = append(ids, job.ids()...)
+	}
+
+	if err := handler.store.Release(context.Background(), ids...); err != nil {
+		handler.errorFunc(fmt.Errorf("webhook: release events: %w", err))
+	}
+}
+
+// retryDelay возвращает задержку перед повторной обработкой события после attempt неудачных попыток.
+func (handler *Handler) retryDelay(attempt int) time.Duration {
+	delay := handler.minRetryDelay
+	for range attempt {
+		if delay >= handler.maxRetryDelay/2 {
+			return handler.maxRetryDelay
+		}
+		delay *= 2
+	}
+	return min(delay, handler.maxRetryDelay)
+}
@@ -0,0 +1,157 @@
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/webhook"
+)
+
+// updateRecord возвращает событие UPDATE товара id с изменённым полем field.
+func updateRecord(id string, i int, field string) *webhook.Record {
+	var (
+		now          = time.Now()
+		auditContext = moysklad.AuditContext{Moment: *moysklad.NewTimestamp(now.Add(time.Duration(i) * time.Second))}
+		event        = moysklad.Event{
+			Action:        moysklad.WebhookActionUpdate,
+			Meta:          *new(moysklad.Meta).SetHref("https://api.moysklad.ru/api/remap/1.2/entity/product/" + id).SetType(moysklad.MetaTypeProduct),
+			UpdatedFields: moysklad.Slice[string]{&field},
+		}
+	)
+
+	return &webhook.Record{
+		ID:           webhook.RecordID(auditContext, event),
+		AuditContext: auditContext,
+		Event:        event,
+		ReceivedAt:   now,
+		NextAttempt:  now,
+	}
+}
+
+// run обрабатывает события хранилища store до их подтверждения.
+func run(t *testing.T, handler *webhook.Handler, store interface{ Len() int }) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- handler.Run(ctx) }()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for store.Len() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d events left in store", store.Len())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	<-done
+}
+
+func TestRunCoalescesUpdates(t *testing.T) {
+	const burst = 20
+
+	store := webhook.NewMemoryStore()
+	ctx := context.Background()
+
+	for i := range burst {
+		if _, err := store.Put(ctx, updateRecord("1", i, fmt.Sprint("field", i%3))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := store.Put(ctx, updateRecord("2", 0, "name")); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+		field = make(map[string]int)
+	)
+
+	handler := webhook.New(webhook.WithStore(store), webhook.WithWorkers(2))
+	handler.Handle(moysklad.MetaTypeProduct, moysklad.WebhookActionUpdate, func(ctx context.Context, event moysklad.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		id := event.Meta.GetUUIDFromHref()
+		calls[id]++
+		field[id] = len(event.UpdatedFields)
+		return nil
+	})
+
+	run(t, handler, store)
+
+	if calls["1"] != 1 || calls["2"] != 1 {
+		t.Fatalf("got calls %v, want one call per entity", calls)
+	}
+	if field["1"] != 3 {
+		t.Fatalf("got %d updated fields, want 3", field["1"])
+	}
+}
+
+func TestMemoryStoreClaimLimit(t *testing.T) {
+	store := webhook.NewMemoryStore()
+	ctx := context.Background()
+
+	for i := range 3 {
+		for j := range 5 {
+			if _, err := store.Put(ctx, updateRecord(fmt.Sprint(i), j, "name")); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	records, err := store.Claim(ctx, 2, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entities := make(map[string]int)
+	for _, record := range records {
+		entities[record.Event.Meta.GetHref()]++
+	}
+	if len(entities) != 2 || len(records) != 10 {
+		t.Fatalf("got %d records of %d entities, want 10 of 2", len(records), len(entities))
+	}
+
+	records, err = store.Claim(ctx, 2, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 5 {
+		t.Fatalf("got %d records, want 5", len(records))
+	}
+}
+
+func TestMemoryStoreRelease(t *testing.T) {
+	store := webhook.NewMemoryStore()
+	ctx := context.Background()
+
+	if _, err := store.Put(ctx, updateRecord("1", 0, "name")); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := store.Claim(ctx, 1, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Release(ctx, records[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err = store.Claim(ctx, 1, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+	if records[0].Attempts != 0 {
+		t.Fatalf("got %d attempts after release, want 0", records[0].Attempts)
+	}
+}
@@ -0,0 +1,234 @@
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"github.com/arcsub/go-moysklad/moysklad"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DedupWindow время, в течение которого ключи обработанных событий хранятся для исключения повторной обработки.
+const DedupWindow = 24 * time.Hour
+
+// Record событие вебхука, сохранённое в хранилище [EventStore].
+type Record struct {
+	ID           string                `json:"id"`           // Ключ события (см. [RecordID])
+	AuditContext moysklad.AuditContext `json:"auditContext"` // Контекст аудита уведомления
+	Event        moysklad.Event        `json:"event"`        // Событие
+	ReceivedAt   time.Time             `json:"receivedAt"`   // Момент получения события
+	NextAttempt  time.Time             `json:"nextAttempt"`  // Момент, начиная с которого событие может быть обработано
+	Attempts     int                   `json:"attempts"`     // Количество неудачных попыток обработки
+}
+
+// RecordID возвращает ключ события, по которому исключаются повторные доставки.
+//
+// Ключ состоит из ссылки на сущность, действия и момента из контекста аудита.
+func RecordID(auditContext moysklad.AuditContext, event moysklad.Event) string {
+	return event.Meta.GetHref() + "|" + string(event.Action) + "|" + auditContext.Moment.Time().Format(time.RFC3339Nano)
+}
+
+// EventStore описывает методы хранилища событий вебхуков.
+//
+// Событие хранится до подтверждения обработки методом Ack.
+// Ключи подтверждённых событий хранятся в течение [DedupWindow].
+type EventStore interface {
+	// Put сохраняет событие.
+	// Возвращает false, если событие с таким же ключом уже сохранено или обработано.
+	Put(ctx context.Context, record *Record) (bool, error)
+
+	// Claim возвращает не более limit событий, готовых к обработке на момент now, и помечает их как взятые в работу.
+	// Готовые события UPDATE одной сущности возвращаются вместе и учитываются в limit как одно событие.
+	// Взятые в работу события не возвращаются повторно до вызова Retry или Release.
+	Claim(ctx context.Context, limit int, now time.Time) ([]*Record, error)
+
+	// Ack подтверждает обработку событий и удаляет их из хранилища.
+	Ack(ctx context.Context, ids ...string) error
+
+	// Retry возвращает событие в очередь для повторной обработки начиная с момента next.
+	Retry(ctx context.Context, id string, next time.Time) error
+
+	// Release возвращает взятые в работу, но не обработанные события в очередь.
+	// В отличие от Retry, не увеличивает количество попыток обработки и не изменяет момент обработки.
+	Release(ctx context.Context, ids ...string) error
+}
+
+// MemoryStore хранилище событий в памяти.
+//
+// События теряются при завершении процесса. Для сохранения событий между запусками используйте [FileStore].
+type MemoryStore struct {
+	records map[string]*memoryRecord
+	done    map[string]time.Time
+	expiry  []doneKey // ключи подтверждённых событий в порядке подтверждения
+	mu      sync.Mutex
+}
+
+type memoryRecord struct {
+	Record
+	claimed bool
+}
+
+// doneKey ключ подтверждённого события и момент подтверждения.
+type doneKey struct {
+	id string
+	at time.Time
+}
+
+// NewMemoryStore возвращает новое хранилище событий в памяти.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: make(map[string]*memoryRecord),
+		done:    make(map[string]time.Time),
+	}
+}
+
+// Len возвращает количество необработанных событий.
+func (store *MemoryStore) Len() int {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return len(store.records)
+}
+
+// Put сохраняет событие.
+func (store *MemoryStore) Put(_ context.Context, record *Record) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.put(record), nil
+}
+
+// Claim возвращает не более limit событий, готовых к обработке, в порядке очереди.
+func (store *MemoryStore) Claim(_ context.Context, limit int, now time.Time) ([]*Record, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	var ready []*memoryRecord
+	for _, record := range store.records {
+		if !record.claimed && !record.NextAttempt.After(now) {
+			ready = append(ready, record)
+		}
+	}
+
+	slices.SortFunc(ready, func(a, b *memoryRecord) int {
+		return cmp.Or(a.NextAttempt.Compare(b.NextAttempt), a.ReceivedAt.Compare(b.ReceivedAt), cmp.Compare(a.ID, b.ID))
+	})
+
+	var (
+		records []*Record
+		count   int
+		updates = make(map[string]bool) // ссылки на сущности взятых в работу событий UPDATE
+	)
+
+	for _, record := range ready {
+		update := record.Event.Action == moysklad.WebhookActionUpdate
+		href := record.Event.Meta.GetHref()
+
+		// события UPDATE одной сущности объединяются обработчиком, поэтому берутся в работу вместе
+		if !update || !updates[href] {
+			if limit > 0 && count == limit {
+				continue
+			}
+			count++
+			if update {
+				updates[href] = true
+			}
+		}
+
+		record.claimed = true
+		r := record.Record
+		records = append(records, &r)
+	}
+
+	return records, nil
+}
+
+// Ack подтверждает обработку событий.
+func (store *MemoryStore) Ack(_ context.Context, ids ...string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.ack(ids, time.Now())
+	return nil
+}
+
+// Retry возвращает событие в очередь.
+func (store *MemoryStore) Retry(_ context.Context, id string, next time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.retry(id, next)
+	return nil
+}
+
+// Release возвращает взятые в работу события в очередь.
+func (store *MemoryStore) Release(_ context.Context, ids ...string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	for _, id := range ids {
+		if record, ok := store.records[id]; ok {
+			record.claimed = false
+		}
+	}
+	return nil
+}
+
+func (store *MemoryStore) put(record *Record) bool {
+	if _, ok := store.records[record.ID]; ok {
+		return false
+	}
+	if _, ok := store.done[record.ID]; ok {
+		return false
+	}
+
+	store.records[record.ID] = &memoryRecord{Record: *record}
+	return true
+}
+
+func (store *MemoryStore) ack(ids []string, at time.Time) {
+	for _, id := range ids {
+		delete(store.records, id)
+		store.done[id] = at
+		store.expiry = append(store.expiry, doneKey{id: id, at: at})
+	}
+
+	store.prune(at)
+}
+
+// prune удаляет ключи событий, подтверждённых раньше, чем за [DedupWindow] до момента at.
+//
+// Ключи проверяются с начала очереди подтверждений до первого неустаревшего ключа.
+func (store *MemoryStore) prune(at time.Time) {
+	n := 0
+	for ; n < len(store.expiry) && at.Sub(store.expiry[n].at) > DedupWindow; n++ {
+		key := store.expiry[n]
+		// событие могло быть подтверждено повторно, тогда ключ хранится до истечения нового срока
+		if doneAt, ok := store.done[key.id]; ok && doneAt.Equal(key.at) {
+			delete(store.done, key.id)
+		}
+	}
+
+	clear(store.expiry[:n])
+	store.expiry = store.expiry[n:]
+}
+
+// doneKeys возвращает ключи подтверждённых событий в порядке подтверждения.
+func (store *MemoryStore) doneKeys() []doneKey {
+	keys := make([]doneKey, 0, len(store.done))
+	for _, key := range store.expiry {
+		if doneAt, ok := store.done[key.id]; ok && doneAt.Equal(key.at) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (store *MemoryStore) retry(id string, next time.Time) {
+	if record, ok := store.records[id]; ok {
+		record.claimed = false
+		record.Attempts++
+		record.NextAttempt = next
+	}
+}
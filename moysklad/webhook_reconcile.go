@@ -0,0 +1,261 @@
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"strings"
+)
+
+// WebhookReconcileOptions параметры приведения вебхуков к желаемому состоянию.
+//
+// Изменяются, включаются, отключаются и удаляются только вебхуки, URL которых начинается с URLPrefix
+// или которые созданы приложением с ID ApplicationID. Необходимо указать хотя бы одно из полей,
+// иначе возвращается ошибка [ErrWebhookOwnerRequired].
+type WebhookReconcileOptions struct {
+	// Префикс URL вебхуков, которыми управляет интеграция.
+	URLPrefix string
+
+	// ID приложения, создавшего вебхуки.
+	ApplicationID string
+
+	// Только сформировать план, не выполняя запросов на изменение.
+	DryRun bool
+}
+
+// ErrWebhookOwnerRequired возвращается, если в [WebhookReconcileOptions] не указаны ни URLPrefix, ни ApplicationID.
+var ErrWebhookOwnerRequired = errors.New("reconcile webhooks: url prefix or application id is required")
+
+// owns возвращает true, если вебхук с указанным URL и приложением принадлежит интеграции.
+func (opts WebhookReconcileOptions) owns(url string, application Meta) bool {
+	if opts.URLPrefix != "" && strings.HasPrefix(url, opts.URLPrefix) {
+		return true
+	}
+	return opts.ApplicationID != "" && application.Href != nil && application.GetUUIDFromHref() == opts.ApplicationID
+}
+
+// WebhookPlan план приведения вебхуков к желаемому состоянию.
+//
+// Вебхуки в Update, Enable и Disable содержат метаданные существующих вебхуков.
+type WebhookPlan[T any] struct {
+	Create  Slice[T] // Вебхуки, которые будут созданы
+	Update  Slice[T] // Вебхуки, параметры которых будут изменены
+	Enable  Slice[T] // Вебхуки, которые будут включены
+	Disable Slice[T] // Вебхуки, которые будут отключены
+	Delete  Slice[T] // Вебхуки, которые будут удалены
+	Applied bool     // План выполнен
+}
+
+// String реализует интерфейс [fmt.Stringer].
+func (plan WebhookPlan[T]) String() string {
+	return fmt.Sprintf("create: %d, update: %d, enable: %d, disable: %d, delete: %d",
+		plan.Create.Len(), plan.Update.Len(), plan.Enable.Len(), plan.Disable.Len(), plan.Delete.Len())
+}
+
+// IsEmpty возвращает true, если существующие вебхуки совпадают с желаемым состоянием.
+func (plan WebhookPlan[T]) IsEmpty() bool {
+	return plan.Create.Len()+plan.Update.Len()+plan.Enable.Len()+plan.Disable.Len()+plan.Delete.Len() == 0
+}
+
+// webhookReconciler описывает, как сравнивать вебхуки одного типа.
+type webhookReconciler[T any] struct {
+	key         func(*T) string               // ключ, по которому сопоставляются желаемые и существующие вебхуки
+	url         func(*T) string               // URL вебхука
+	application func(*T) Meta                 // метаданные приложения, создавшего вебхук
+	enabled     func(*T) bool                 // состояние вебхука
+	setEnabled  func(*T, bool) *T             // устанавливает состояние вебхука
+	equal       func(actual, desired *T) bool // совпадение параметров вебхука без учёта состояния
+	update      func(actual, desired *T) *T   // вебхук с метаданными actual и параметрами desired
+}
+
+// ReconcileWebhooks приводит вебхуки учётной записи к желаемому состоянию desired.
+//
+// Вебхуки сопоставляются по URL, типу сущности и действию.
+// Вебхуки, отсутствующие в desired, удаляются, если принадлежат интеграции (см. [WebhookReconcileOptions]).
+// Состояние вебхука, для которого не указан флаг Enabled, считается включённым.
+//
+// Возвращает план изменений. Если указан параметр DryRun, план не выполняется.
+func ReconcileWebhooks(ctx context.Context, client *Client, desired []Webhook, opts WebhookReconcileOptions) (*WebhookPlan[Webhook], error) {
+	diffType := func(webhook *Webhook) WebhookDiff {
+		if webhook.Action != WebhookActionUpdate || webhook.DiffType == "" {
+			return WebhookDiffNone
+		}
+		return webhook.DiffType
+	}
+
+	reconciler := webhookReconciler[Webhook]{
+		key: func(webhook *Webhook) string {
+			return strings.Join([]string{webhook.GetURL(), string(webhook.EntityType), string(webhook.Action)}, " ")
+		},
+		url: (*Webhook).GetURL,
+		application: func(webhook *Webhook) Meta {
+			return webhook.GetAuthorApplication().GetMeta()
+		},
+		enabled: func(webhook *Webhook) bool {
+			return webhook.Enabled == nil || *webhook.Enabled
+		},
+		setEnabled: (*Webhook).SetEnabled,
+		equal: func(actual, desired *Webhook) bool {
+			return diffType(actual) == diffType(desired)
+		},
+		update: func(actual, desired *Webhook) *Webhook {
+			webhook := &Webhook{Meta: actual.Meta}
+			// режим отображения изменения указывается только для действия UPDATE
+			if desired.Action == WebhookActionUpdate {
+				webhook.DiffType = diffType(desired)
+			}
+			return webhook
+		},
+	}
+
+	service := NewWebhookService(client)
+	return reconcileWebhooks(ctx, reconciler, service.GetListAll, service.CreateUpdateMany, service.DeleteMany, desired, opts)
+}
+
+// ReconcileWebhookStocks приводит вебхуки на изменение остатков учётной записи к желаемому состоянию desired.
+//
+// Вебхуки сопоставляются по URL и изменяются при различии типа остатков или типа отчёта остатков.
+// Остальные правила совпадают с [ReconcileWebhooks].
+func ReconcileWebhookStocks(ctx context.Context, client *Client, desired []WebhookStock, opts WebhookReconcileOptions) (*WebhookPlan[WebhookStock], error) {
+	reconciler := webhookReconciler[WebhookStock]{
+		key: func(webhookStock *WebhookStock) string {
+			return webhookStock.GetURL()
+		},
+		url:         (*WebhookStock).GetURL,
+		application: (*WebhookStock).GetAuthorApplication,
+		enabled: func(webhookStock *WebhookStock) bool {
+			return webhookStock.Enabled == nil || *webhookStock.Enabled
+		},
+		setEnabled: (*WebhookStock).SetEnabled,
+		equal: func(actual, desired *WebhookStock) bool {
+			// тип остатков, не указанный в желаемом вебхуке, устанавливается сервером
+			if desired.StockType != nil && actual.GetStockType() != desired.GetStockType() {
+				return false
+			}
+			return actual.ReportType == desired.ReportType
+		},
+		update: func(actual, desired *WebhookStock) *WebhookStock {
+			return &WebhookStock{Meta: actual.Meta, StockType: desired.StockType, ReportType: desired.ReportType}
+		},
+	}
+
+	service := NewWebhookStockService(client)
+	return reconcileWebhooks(ctx, reconciler, service.GetListAll, service.CreateUpdateMany, service.DeleteMany, desired, opts)
+}
+
+// reconcileWebhooks формирует и, если не указан параметр DryRun, выполняет план приведения вебхуков к желаемому состоянию.
+func reconcileWebhooks[T any](
+	ctx context.Context,
+	reconciler webhookReconciler[T],
+	getListAll func(context.Context, ...func(*Params)) (*Slice[T], *resty.Response, error),
+	createUpdateMany func(context.Context, Slice[T], ...func(*Params)) (*BulkResult[T], *resty.Response, error),
+	deleteMany func(context.Context, ...*T) (*BulkResult[DeleteManyRow], *resty.Response, error),
+	desired []T,
+	opts WebhookReconcileOptions,
+) (*WebhookPlan[T], error) {
+	if opts.URLPrefix == "" && opts.ApplicationID == "" {
+		return nil, ErrWebhookOwnerRequired
+	}
+
+	wanted := make(map[string]*T, len(desired))
+	for i := range desired {
+		webhook := &desired[i]
+		key := reconciler.key(webhook)
+
+		// созданный вебхук должен принадлежать интеграции, иначе при следующем вызове он не будет найден
+		if opts.URLPrefix != "" && opts.ApplicationID == "" && !strings.HasPrefix(reconciler.url(webhook), opts.URLPrefix) {
+			return nil, fmt.Errorf("reconcile webhooks: %s does not match url prefix %s", key, opts.URLPrefix)
+		}
+		if _, ok := wanted[key]; ok {
+			return nil, fmt.Errorf("reconcile webhooks: duplicate webhook %s", key)
+		}
+		wanted[key] = webhook
+	}
+
+	actual, _, err := getListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		plan  = new(WebhookPlan[T])
+		found = make(map[string]struct{}, len(desired))
+	)
+
+	for _, webhook := range *actual {
+		if webhook == nil {
+			continue
+		}
+
+		key := reconciler.key(webhook)
+		want, ok := wanted[key]
+
+		if _, seen := found[key]; !ok || seen {
+			// дубликаты желаемых вебхуков также удаляются
+			if opts.owns(reconciler.url(webhook), reconciler.application(webhook)) {
+				plan.Delete.Push(webhook)
+			}
+			continue
+		}
+		found[key] = struct{}{}
+
+		// вебхук, совпадающий с желаемым, но не принадлежащий интеграции, не изменяется
+		if !opts.owns(reconciler.url(webhook), reconciler.application(webhook)) {
+			continue
+		}
+
+		enabled := reconciler.enabled(want)
+		switch {
+		case !reconciler.equal(webhook, want):
+			plan.Update.Push(reconciler.setEnabled(reconciler.update(webhook, want), enabled))
+		case enabled && !reconciler.enabled(webhook):
+			plan.Enable.Push(reconciler.setEnabled(reconciler.update(webhook, webhook), true))
+		case !enabled && reconciler.enabled(webhook):
+			plan.Disable.Push(reconciler.setEnabled(reconciler.update(webhook, webhook), false))
+		}
+	}
+
+	for i := range desired {
+		if _, ok := found[reconciler.key(&desired[i])]; !ok {
+			plan.Create.Push(&desired[i])
+		}
+	}
+
+	if opts.DryRun || plan.IsEmpty() {
+		return plan, nil
+	}
+
+	var errs []error
+
+	var changes Slice[T]
+	changes.Push(plan.Create...)
+	changes.Push(plan.Update...)
+	changes.Push(plan.Enable...)
+	changes.Push(plan.Disable...)
+
+	if changes.Len() > 0 {
+		result, _, err := createUpdateMany(ctx, changes)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			for _, item := range result.Failed() {
+				errs = append(errs, item.Error)
+			}
+		}
+	}
+
+	if plan.Delete.Len() > 0 {
+		result, _, err := deleteMany(ctx, plan.Delete...)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			for _, item := range result.Failed() {
+				errs = append(errs, item.Error)
+			}
+		}
+	}
+
+	plan.Applied = len(errs) == 0
+	return plan, errors.Join(errs...)
+}
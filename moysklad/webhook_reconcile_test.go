@@ -0,0 +1,138 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/arcsub/go-moysklad/moysklad"
+	"github.com/arcsub/go-moysklad/moysklad/mstest"
+)
+
+func TestReconcileWebhookStocks(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	const applicationID = "00000000-0000-0000-0000-0000000000aa"
+	application := new(moysklad.Meta).SetHref("https://api.moysklad.ru/api/remap/1.2/entity/application/" + applicationID)
+
+	err := server.Seed(
+		// принадлежит приложению, тип отчёта отличается
+		&moysklad.WebhookStock{
+			URL:               moysklad.String("https://example.com/stock"),
+			ReportType:        moysklad.WebhookReportByStore,
+			AuthorApplication: application,
+		},
+		// не принадлежит приложению, отключён, тип отчёта отличается
+		&moysklad.WebhookStock{
+			URL:        moysklad.String("https://example.com/store"),
+			ReportType: moysklad.WebhookReportAll,
+			Enabled:    moysklad.Bool(false),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired := []moysklad.WebhookStock{
+		{URL: moysklad.String("https://example.com/stock"), StockType: moysklad.String("stock"), ReportType: moysklad.WebhookReportAll},
+		{URL: moysklad.String("https://example.com/store"), StockType: moysklad.String("stock"), ReportType: moysklad.WebhookReportByStore},
+	}
+
+	client := server.Client(moysklad.Config{Token: "test"})
+	plan, err := moysklad.ReconcileWebhookStocks(context.Background(), client, desired, moysklad.WebhookReconcileOptions{
+		ApplicationID: applicationID,
+		DryRun:        true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Update.Len() != 1 || plan.Update[0].GetReportType() != moysklad.WebhookReportAll {
+		t.Fatalf("got plan %s, want update of report type", plan)
+	}
+	if plan.Create.Len()+plan.Enable.Len()+plan.Disable.Len()+plan.Delete.Len() != 0 {
+		t.Fatalf("got plan %s, want only update", plan)
+	}
+
+	opts := moysklad.WebhookReconcileOptions{ApplicationID: applicationID}
+	if plan, err = moysklad.ReconcileWebhookStocks(context.Background(), client, desired, opts); err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Applied {
+		t.Fatalf("plan %s not applied", plan)
+	}
+
+	// после применения плана изменения не требуются
+	opts.DryRun = true
+	if plan, err = moysklad.ReconcileWebhookStocks(context.Background(), client, desired, opts); err != nil {
+		t.Fatal(err)
+	}
+	if !plan.IsEmpty() {
+		t.Fatalf("got plan %s after apply, want empty", plan)
+	}
+}
+
+func TestReconcileWebhooksOwnerRequired(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	client := server.Client(moysklad.Config{Token: "test"})
+	_, err := moysklad.ReconcileWebhooks(context.Background(), client, nil, moysklad.WebhookReconcileOptions{DryRun: true})
+	if !errors.Is(err, moysklad.ErrWebhookOwnerRequired) {
+		t.Fatalf("got error %v, want ErrWebhookOwnerRequired", err)
+	}
+	if n := server.Requests(); n != 0 {
+		t.Fatalf("got %d requests, want 0", n)
+	}
+}
+
+func TestReconcileWebhooksDiffType(t *testing.T) {
+	server := mstest.NewServer()
+	defer server.Close()
+
+	const url = "https://example.com/hook"
+
+	err := server.Seed(
+		// режим отображения изменения отличается
+		&moysklad.Webhook{
+			URL:        moysklad.String(url),
+			EntityType: moysklad.MetaTypeCustomerOrder,
+			Action:     moysklad.WebhookActionUpdate,
+			DiffType:   moysklad.WebhookDiffNone,
+		},
+		// включён, желаемое состояние – отключён
+		&moysklad.Webhook{
+			URL:        moysklad.String(url),
+			EntityType: moysklad.MetaTypeCustomerOrder,
+			Action:     moysklad.WebhookActionCreate,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired := []moysklad.Webhook{
+		{URL: moysklad.String(url), EntityType: moysklad.MetaTypeCustomerOrder, Action: moysklad.WebhookActionUpdate, DiffType: moysklad.WebhookDiffFields},
+		{URL: moysklad.String(url), EntityType: moysklad.MetaTypeCustomerOrder, Action: moysklad.WebhookActionCreate, DiffType: moysklad.WebhookDiffFields, Enabled: moysklad.Bool(false)},
+	}
+
+	client := server.Client(moysklad.Config{Token: "test"})
+	plan, err := moysklad.ReconcileWebhooks(context.Background(), client, desired, moysklad.WebhookReconcileOptions{
+		URLPrefix: "https://example.com/",
+		DryRun:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Update.Len() != 1 || plan.Update[0].DiffType != moysklad.WebhookDiffFields {
+		t.Fatalf("got plan %s, want update with diffType %s", plan, moysklad.WebhookDiffFields)
+	}
+	if plan.Disable.Len() != 1 || plan.Disable[0].DiffType != "" {
+		t.Fatalf("got plan %s, want disable without diffType", plan)
+	}
+	if plan.Create.Len()+plan.Enable.Len()+plan.Delete.Len() != 0 {
+		t.Fatalf("got plan %s, want update and disable", plan)
+	}
+}
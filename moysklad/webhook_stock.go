@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // WebhookStock Вебхук на изменение остатков.
@@ -139,8 +140,9 @@ func (webhookStock *WebhookStock) Delete(ctx context.Context, client *Client) (b
 
 // MarshalJSON реализует интерфейс [json.Marshaler].
 func (webhookStock WebhookStock) MarshalJSON() ([]byte, error) {
+	type alias WebhookStock
 	webhookStock.StockType = String("stock")
-	return json.Marshal(webhookStock)
+	return json.Marshal(alias(webhookStock))
 }
 
 // WebhookReport Тип отчёта остатков, к которым привязан вебхук на изменение остатков.
@@ -167,6 +169,21 @@ type WebhookStockService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[WebhookStock], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для постраничного получения всех вебхуков на изменение остатков.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который запрашивает страницы по мере перебора элементов.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*WebhookStock, error]
+
+	// GetListAsync выполняет асинхронный запрос на получение всех вебхуков на изменение остатков.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает сервис AsyncResultService для обработки данного запроса.
+	GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[WebhookStock]], *resty.Response, error)
+
+	// GetListAllAsync возвращает итератор для получения всех вебхуков на изменение остатков асинхронной задачей.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который читает объекты из результата задачи по мере перебора элементов.
+	GetListAllAsync(ctx context.Context, params ...func(*Params)) iter.Seq2[*WebhookStock, error]
+
 	// Create выполняет запрос на создание вебхука на изменение остатков.
 	// Обязательные поля для заполнения:
 	//	- reportType (Тип отчета остатков, к которым привязан вебхук на изменение остатков)
@@ -178,13 +195,13 @@ type WebhookStockService interface {
 	// CreateUpdateMany выполняет запрос на массовое создание и/или изменение вебхуков на изменение остатков.
 	// Изменяемые вебхуки на изменение остатков должны содержать идентификатор в виде метаданных.
 	// Принимает контекст, список вебхуков на изменение остатков и опционально объект параметров запроса Params.
-	// Возвращает список созданных и/или изменённых вебхуков на изменение остатков.
-	CreateUpdateMany(ctx context.Context, webhookStockList Slice[WebhookStock], params ...func(*Params)) (*Slice[WebhookStock], *resty.Response, error)
+	// Возвращает объект BulkResult со списком созданных и/или изменённых вебхуков на изменение остатков и ошибками по каждому элементу.
+	CreateUpdateMany(ctx context.Context, webhookStockList Slice[WebhookStock], params ...func(*Params)) (*BulkResult[WebhookStock], *resty.Response, error)
 
 	// DeleteMany выполняет запрос на массовое удаление вебхуков на изменение остатков.
 	// Принимает контекст и множество вебхуков на изменение остатков.
-	// Возвращает объект DeleteManyResponse, содержащий информацию об успешном удалении или ошибку.
-	DeleteMany(ctx context.Context, entities ...*WebhookStock) (*DeleteManyResponse, *resty.Response, error)
+	// Возвращает объект BulkResult, содержащий информацию об успешном удалении или ошибку по каждому элементу.
+	DeleteMany(ctx context.Context, entities ...*WebhookStock) (*BulkResult[DeleteManyRow], *resty.Response, error)
 
 	// DeleteByID выполняет запрос на удаление вебхука на изменение остатков по ID.
 	// Принимает контекст и ID вебхука на изменение остатков.